@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptest
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// Default URL paths of the OTLP/HTTP endpoints.
+const (
+	TracesPath  = "/v1/traces"
+	MetricsPath = "/v1/metrics"
+	LogsPath    = "/v1/logs"
+)
+
+// Protocol is the protocol an export request is received with.
+type Protocol int
+
+const (
+	// ProtocolGRPC is OTLP/gRPC.
+	ProtocolGRPC Protocol = iota
+	// ProtocolHTTPProtobuf is OTLP/HTTP with binary protobuf encoding.
+	ProtocolHTTPProtobuf
+	// ProtocolHTTPJSON is OTLP/HTTP with JSON protobuf encoding.
+	ProtocolHTTPJSON
+)
+
+// String returns the name of p.
+func (p Protocol) String() string {
+	switch p {
+	case ProtocolGRPC:
+		return "grpc"
+	case ProtocolHTTPProtobuf:
+		return "http/protobuf"
+	case ProtocolHTTPJSON:
+		return "http/json"
+	default:
+		return "unknown"
+	}
+}
+
+// Signal is a kind of telemetry received by a [Collector].
+type Signal int
+
+const (
+	// SignalTraces are spans.
+	SignalTraces Signal = iota
+	// SignalMetrics are metrics.
+	SignalMetrics
+	// SignalLogs are log records.
+	SignalLogs
+)
+
+// Request is an export request received by a [Collector].
+type Request[T proto.Message] struct {
+	// Protocol is the protocol the request was received with.
+	Protocol Protocol
+	// Header holds the gRPC metadata or HTTP headers of the request. The keys
+	// are canonicalized with [http.CanonicalHeaderKey] for both protocols.
+	Header http.Header
+	// Message is the decoded request.
+	Message T
+}
+
+// store holds the requests received and the responses queued for a signal.
+type store[T proto.Message] struct {
+	mu        sync.Mutex
+	requests  []Request[T]
+	responses []Response
+}
+
+// add stores r and returns the response to it.
+func (s *store[T]) add(r Request[T]) Response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r)
+	if len(s.responses) == 0 {
+		return Response{}
+	}
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+	return resp
+}
+
+func (s *store[T]) get() []Request[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+func (s *store[T]) respond(rs []Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = append(s.responses, rs...)
+}
+
+func (s *store[T]) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.responses = nil
+}
+
+// Collector is an in-process OTLP collector that receives traces, metrics,
+// and logs over gRPC and HTTP.
+//
+// A Collector stores all export requests it receives. It responds with
+// success, unless responses are queued with [Collector.Respond].
+type Collector struct {
+	traces  store[*coltracepb.ExportTraceServiceRequest]
+	metrics store[*colmetricpb.ExportMetricsServiceRequest]
+	logs    store[*collogpb.ExportLogsServiceRequest]
+
+	grpcListener net.Listener
+	grpcSrv      *grpc.Server
+	httpListener net.Listener
+	httpSrv      *http.Server
+
+	// done is closed on shutdown to end the delay of all responses.
+	done         chan struct{}
+	shutdownOnce sync.Once
+}
+
+// NewCollector returns a started Collector configured with opts.
+//
+// By default, the Collector listens on the localhost interface at OS chosen
+// ports. Use [Collector.GRPCEndpoint] and [Collector.HTTPEndpoint] to
+// configure exporters.
+func NewCollector(opts ...Option) (*Collector, error) {
+	cfg := newConfig(opts)
+	c := &Collector{done: make(chan struct{})}
+
+	var err error
+	lc := net.ListenConfig{}
+	c.grpcListener, err = lc.Listen(context.Background(), "tcp", cfg.grpcAddr)
+	if err != nil {
+		return nil, err
+	}
+	c.httpListener, err = lc.Listen(context.Background(), "tcp", cfg.httpAddr)
+	if err != nil {
+		return nil, errors.Join(err, c.grpcListener.Close())
+	}
+
+	c.grpcSrv = grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(c.grpcSrv, &traceServer{c: c})
+	colmetricpb.RegisterMetricsServiceServer(c.grpcSrv, &metricsServer{c: c})
+	collogpb.RegisterLogsServiceServer(c.grpcSrv, &logsServer{c: c})
+
+	mux := http.NewServeMux()
+	mux.Handle(TracesPath, httpHandler(c, &c.traces, newTraceResponse))
+	mux.Handle(MetricsPath, httpHandler(c, &c.metrics, newMetricsResponse))
+	mux.Handle(LogsPath, httpHandler(c, &c.logs, newLogsResponse))
+	c.httpSrv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() { _ = c.grpcSrv.Serve(c.grpcListener) }()
+	go func() { _ = c.httpSrv.Serve(c.httpListener) }()
+	return c, nil
+}
+
+// GRPCEndpoint returns the host and port the OTLP/gRPC endpoint of c listens
+// at.
+func (c *Collector) GRPCEndpoint() string {
+	return c.grpcListener.Addr().String()
+}
+
+// HTTPEndpoint returns the host and port the OTLP/HTTP endpoint of c listens
+// at. The signals are served at their default URL paths.
+func (c *Collector) HTTPEndpoint() string {
+	return c.httpListener.Addr().String()
+}
+
+// Respond queues rs as the responses to the next export requests of signal s
+// received over any protocol, in order. Once all queued responses are used,
+// export requests succeed.
+func (c *Collector) Respond(s Signal, rs ...Response) {
+	switch s {
+	case SignalTraces:
+		c.traces.respond(rs)
+	case SignalMetrics:
+		c.metrics.respond(rs)
+	case SignalLogs:
+		c.logs.respond(rs)
+	}
+}
+
+// TraceRequests returns all trace export requests received.
+func (c *Collector) TraceRequests() []Request[*coltracepb.ExportTraceServiceRequest] {
+	return c.traces.get()
+}
+
+// MetricRequests returns all metric export requests received.
+func (c *Collector) MetricRequests() []Request[*colmetricpb.ExportMetricsServiceRequest] {
+	return c.metrics.get()
+}
+
+// LogRequests returns all log export requests received.
+func (c *Collector) LogRequests() []Request[*collogpb.ExportLogsServiceRequest] {
+	return c.logs.get()
+}
+
+// ResourceSpans returns the resource spans of all trace export requests
+// received.
+func (c *Collector) ResourceSpans() []*tracepb.ResourceSpans {
+	var out []*tracepb.ResourceSpans
+	for _, r := range c.traces.get() {
+		out = append(out, r.Message.GetResourceSpans()...)
+	}
+	return out
+}
+
+// ResourceMetrics returns the resource metrics of all metric export requests
+// received.
+func (c *Collector) ResourceMetrics() []*metricpb.ResourceMetrics {
+	var out []*metricpb.ResourceMetrics
+	for _, r := range c.metrics.get() {
+		out = append(out, r.Message.GetResourceMetrics()...)
+	}
+	return out
+}
+
+// ResourceLogs returns the resource logs of all log export requests received.
+func (c *Collector) ResourceLogs() []*logpb.ResourceLogs {
+	var out []*logpb.ResourceLogs
+	for _, r := range c.logs.get() {
+		out = append(out, r.Message.GetResourceLogs()...)
+	}
+	return out
+}
+
+// Reset removes all received requests and queued responses.
+func (c *Collector) Reset() {
+	c.traces.reset()
+	c.metrics.reset()
+	c.logs.reset()
+}
+
+// Shutdown stops c. Open connections are closed and delayed responses are
+// sent immediately.
+//
+// The deadline or cancellation of ctx is honored while waiting for HTTP
+// requests to complete.
+func (c *Collector) Shutdown(ctx context.Context) error {
+	var err error
+	c.shutdownOnce.Do(func() {
+		close(c.done)
+		c.grpcSrv.Stop()
+		err = c.httpSrv.Shutdown(ctx)
+	})
+	return err
+}
+
+// wait waits for the delay of r unless ctx is done or c is shut down.
+func (c *Collector) wait(ctx context.Context, r Response) {
+	if r.Delay <= 0 {
+		return
+	}
+	timer := time.NewTimer(r.Delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	case <-c.done:
+	}
+}
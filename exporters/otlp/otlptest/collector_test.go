@@ -0,0 +1,294 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+var (
+	traceReq = &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			ScopeSpans: []*tracepb.ScopeSpans{{Spans: []*tracepb.Span{{Name: "span"}}}},
+		}},
+	}
+	metricsReq = &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{{
+			ScopeMetrics: []*metricpb.ScopeMetrics{{Metrics: []*metricpb.Metric{{Name: "metric"}}}},
+		}},
+	}
+	logsReq = &collogpb.ExportLogsServiceRequest{
+		ResourceLogs: []*logpb.ResourceLogs{{
+			ScopeLogs: []*logpb.ScopeLogs{{LogRecords: []*logpb.LogRecord{{EventName: "log"}}}},
+		}},
+	}
+)
+
+func newCollector(t *testing.T) *Collector {
+	t.Helper()
+	c, err := NewCollector()
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, c.Shutdown(context.Background())) })
+	return c
+}
+
+func dial(t *testing.T, c *Collector) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient(c.GRPCEndpoint(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+	return conn
+}
+
+func post(t *testing.T, c *Collector, path string, msg proto.Message, header http.Header) *http.Response {
+	t.Helper()
+	body, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	url := "http://" + c.HTTPEndpoint() + path
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header = header.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	req.Header.Set("Content-Type", contentTypeProtobuf)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestCollectorGRPC(t *testing.T) {
+	c := newCollector(t)
+	conn := dial(t, c)
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "x-tenant", "a")
+
+	_, err := coltracepb.NewTraceServiceClient(conn).Export(ctx, traceReq)
+	require.NoError(t, err)
+	_, err = colmetricpb.NewMetricsServiceClient(conn).Export(ctx, metricsReq)
+	require.NoError(t, err)
+	_, err = collogpb.NewLogsServiceClient(conn).Export(ctx, logsReq)
+	require.NoError(t, err)
+
+	traces := c.TraceRequests()
+	require.Len(t, traces, 1)
+	assert.Equal(t, ProtocolGRPC, traces[0].Protocol)
+	assert.Equal(t, "a", traces[0].Header.Get("X-Tenant"))
+	assert.True(t, proto.Equal(traceReq, traces[0].Message))
+
+	require.Len(t, c.ResourceSpans(), 1)
+	require.Len(t, c.ResourceMetrics(), 1)
+	assert.Equal(t, "metric", c.ResourceMetrics()[0].ScopeMetrics[0].Metrics[0].Name)
+	require.Len(t, c.ResourceLogs(), 1)
+	assert.Equal(t, "log", c.ResourceLogs()[0].ScopeLogs[0].LogRecords[0].EventName)
+
+	c.Reset()
+	assert.Empty(t, c.TraceRequests())
+	assert.Empty(t, c.MetricRequests())
+	assert.Empty(t, c.LogRequests())
+}
+
+func TestCollectorGRPCResponses(t *testing.T) {
+	c := newCollector(t)
+	client := coltracepb.NewTraceServiceClient(dial(t, c))
+
+	c.Respond(SignalTraces,
+		Response{Code: codes.Unavailable, Message: "down", RetryAfter: 3 * time.Second},
+		Response{Rejected: 2, PartialSuccessMessage: "dropped"},
+	)
+
+	_, err := client.Export(t.Context(), traceReq)
+	s := status.Convert(err)
+	assert.Equal(t, codes.Unavailable, s.Code())
+	assert.Equal(t, "down", s.Message())
+	require.Len(t, s.Details(), 1)
+	info, ok := s.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Second, info.RetryDelay.AsDuration())
+
+	resp, err := client.Export(t.Context(), traceReq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetPartialSuccess().GetRejectedSpans())
+	assert.Equal(t, "dropped", resp.GetPartialSuccess().GetErrorMessage())
+
+	// Once all responses are used, requests succeed.
+	resp, err = client.Export(t.Context(), traceReq)
+	require.NoError(t, err)
+	assert.Nil(t, resp.GetPartialSuccess())
+
+	// Failed requests are stored as well.
+	assert.Len(t, c.TraceRequests(), 3)
+}
+
+func TestCollectorHTTP(t *testing.T) {
+	c := newCollector(t)
+
+	resp := post(t, c, TracesPath, traceReq, http.Header{"X-Tenant": {"a"}})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = post(t, c, MetricsPath, metricsReq, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = post(t, c, LogsPath, logsReq, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	traces := c.TraceRequests()
+	require.Len(t, traces, 1)
+	assert.Equal(t, ProtocolHTTPProtobuf, traces[0].Protocol)
+	assert.Equal(t, "a", traces[0].Header.Get("X-Tenant"))
+	assert.True(t, proto.Equal(traceReq, traces[0].Message))
+	assert.Len(t, c.MetricRequests(), 1)
+	assert.Len(t, c.LogRequests(), 1)
+}
+
+func TestCollectorHTTPEncodings(t *testing.T) {
+	c := newCollector(t)
+	url := "http://" + c.HTTPEndpoint() + TracesPath
+
+	body, err := protojson.Marshal(traceReq)
+	require.NoError(t, err)
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	_, err = w.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, url, &gz)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, contentTypeJSON, resp.Header.Get("Content-Type"))
+
+	traces := c.TraceRequests()
+	require.Len(t, traces, 1)
+	assert.Equal(t, ProtocolHTTPJSON, traces[0].Protocol)
+	assert.True(t, proto.Equal(traceReq, traces[0].Message))
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodPost, url, bytes.NewReader([]byte("invalid")))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentTypeProtobuf)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, url, http.NoBody)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	assert.Len(t, c.TraceRequests(), 1, "invalid requests stored")
+}
+
+func TestCollectorHTTPResponses(t *testing.T) {
+	c := newCollector(t)
+
+	c.Respond(SignalLogs,
+		Response{Code: codes.ResourceExhausted, Message: "slow down", RetryAfter: 1500 * time.Millisecond},
+		Response{Rejected: 1, PartialSuccessMessage: "dropped"},
+	)
+
+	resp := post(t, c, LogsPath, logsReq, nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var got spb.Status
+	require.NoError(t, proto.Unmarshal(body, &got))
+	assert.Equal(t, int32(codes.ResourceExhausted), got.GetCode())
+	assert.Equal(t, "slow down", got.GetMessage())
+
+	resp = post(t, c, LogsPath, logsReq, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var partial collogpb.ExportLogsServiceResponse
+	require.NoError(t, proto.Unmarshal(body, &partial))
+	assert.Equal(t, int64(1), partial.GetPartialSuccess().GetRejectedLogRecords())
+}
+
+func TestCollectorDelay(t *testing.T) {
+	c := newCollector(t)
+	client := colmetricpb.NewMetricsServiceClient(dial(t, c))
+
+	c.Respond(SignalMetrics, Response{Delay: time.Hour})
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Export(ctx, metricsReq)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	const delay = 20 * time.Millisecond
+	c.Respond(SignalMetrics, Response{Delay: delay})
+	start := time.Now()
+	_, err = client.Export(t.Context(), metricsReq)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), delay)
+}
+
+func TestCollectorShutdownEndsDelay(t *testing.T) {
+	c, err := NewCollector()
+	require.NoError(t, err)
+	c.Respond(SignalTraces, Response{Delay: time.Hour})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = post(t, c, TracesPath, traceReq, nil)
+	}()
+	require.Eventually(t, func() bool { return len(c.TraceRequests()) == 1 }, time.Second, time.Millisecond)
+
+	require.NoError(t, c.Shutdown(t.Context()))
+	<-done
+	assert.NoError(t, c.Shutdown(t.Context()), "second shutdown")
+}
+
+func TestResponseHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.Aborted, http.StatusBadGateway},
+		{codes.Internal, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Response{Code: tt.code}.httpStatus(), tt.code.String())
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptest
+
+const defaultAddr = "localhost:0"
+
+type config struct {
+	grpcAddr string
+	httpAddr string
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		grpcAddr: defaultAddr,
+		httpAddr: defaultAddr,
+	}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option applies a configuration option to a [Collector].
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithGRPCAddress sets the TCP address the OTLP/gRPC endpoint listens at, in
+// the form "host:port".
+//
+// By default, the localhost interface is used at an OS chosen port.
+func WithGRPCAddress(addr string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.grpcAddr = addr
+		return cfg
+	})
+}
+
+// WithHTTPAddress sets the TCP address the OTLP/HTTP endpoint listens at, in
+// the form "host:port".
+//
+// By default, the localhost interface is used at an OS chosen port.
+func WithHTTPAddress(addr string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.httpAddr = addr
+		return cfg
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otlptest provides an in-process OTLP collector to test the export of
+telemetry end to end.
+
+A [Collector] receives traces, metrics, and logs over gRPC and HTTP. It
+stores every export request it receives, along with the protocol and headers
+used, so tests can inspect them. The responses of the Collector can be
+controlled with [Collector.Respond] to test how exporters handle errors,
+delays, partial success, and throttling.
+
+	c, err := otlptest.NewCollector()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Shutdown(context.Background())
+
+	exp, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(c.GRPCEndpoint()),
+		otlptracegrpc.WithInsecure(),
+	)
+
+The Collector does not use TLS. Configure exporters to connect insecurely.
+*/
+package otlptest
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptest
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+type traceServer struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	c *Collector
+}
+
+func (s *traceServer) Export(
+	ctx context.Context,
+	req *coltracepb.ExportTraceServiceRequest,
+) (*coltracepb.ExportTraceServiceResponse, error) {
+	r, err := receiveGRPC(ctx, s.c, &s.c.traces, req)
+	if err != nil {
+		return nil, err
+	}
+	return newTraceResponse(r), nil
+}
+
+type metricsServer struct {
+	colmetricpb.UnimplementedMetricsServiceServer
+
+	c *Collector
+}
+
+func (s *metricsServer) Export(
+	ctx context.Context,
+	req *colmetricpb.ExportMetricsServiceRequest,
+) (*colmetricpb.ExportMetricsServiceResponse, error) {
+	r, err := receiveGRPC(ctx, s.c, &s.c.metrics, req)
+	if err != nil {
+		return nil, err
+	}
+	return newMetricsResponse(r), nil
+}
+
+type logsServer struct {
+	collogpb.UnimplementedLogsServiceServer
+
+	c *Collector
+}
+
+func (s *logsServer) Export(
+	ctx context.Context,
+	req *collogpb.ExportLogsServiceRequest,
+) (*collogpb.ExportLogsServiceResponse, error) {
+	r, err := receiveGRPC(ctx, s.c, &s.c.logs, req)
+	if err != nil {
+		return nil, err
+	}
+	return newLogsResponse(r), nil
+}
+
+// receiveGRPC stores the gRPC request req in s and returns the response to
+// it. The returned error is the gRPC status of a failed export.
+func receiveGRPC[T proto.Message](ctx context.Context, c *Collector, s *store[T], req T) (Response, error) {
+	header := http.Header{}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, v := range md {
+			key := http.CanonicalHeaderKey(k)
+			header[key] = append(header[key], v...)
+		}
+	}
+
+	r := s.add(Request[T]{Protocol: ProtocolGRPC, Header: header, Message: req})
+	c.wait(ctx, r)
+	if r.Code != codes.OK {
+		return r, r.status().Err()
+	}
+	return r, nil
+}
+
+func newTraceResponse(r Response) *coltracepb.ExportTraceServiceResponse {
+	resp := &coltracepb.ExportTraceServiceResponse{}
+	if r.partialSuccess() {
+		resp.PartialSuccess = &coltracepb.ExportTracePartialSuccess{
+			RejectedSpans: r.Rejected,
+			ErrorMessage:  r.PartialSuccessMessage,
+		}
+	}
+	return resp
+}
+
+func newMetricsResponse(r Response) *colmetricpb.ExportMetricsServiceResponse {
+	resp := &colmetricpb.ExportMetricsServiceResponse{}
+	if r.partialSuccess() {
+		resp.PartialSuccess = &colmetricpb.ExportMetricsPartialSuccess{
+			RejectedDataPoints: r.Rejected,
+			ErrorMessage:       r.PartialSuccessMessage,
+		}
+	}
+	return resp
+}
+
+func newLogsResponse(r Response) *collogpb.ExportLogsServiceResponse {
+	resp := &collogpb.ExportLogsServiceResponse{}
+	if r.partialSuccess() {
+		resp.PartialSuccess = &collogpb.ExportLogsPartialSuccess{
+			RejectedLogRecords: r.Rejected,
+			ErrorMessage:       r.PartialSuccessMessage,
+		}
+	}
+	return resp
+}
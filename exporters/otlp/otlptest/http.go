@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptest
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+)
+
+// httpHandler returns the OTLP/HTTP handler of the requests stored in s.
+// newResponse returns the response message of a successful export.
+func httpHandler[T proto.Message, R proto.Message](
+	c *Collector,
+	s *store[T],
+	newResponse func(Response) R,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		protocol := ProtocolHTTPProtobuf
+		if mt, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mt == contentTypeJSON {
+			protocol = ProtocolHTTPJSON
+		}
+
+		var msg T
+		msg = msg.ProtoReflect().New().Interface().(T)
+		if err := decode(req, protocol, msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		r := s.add(Request[T]{Protocol: protocol, Header: req.Header.Clone(), Message: msg})
+		c.wait(req.Context(), r)
+
+		if r.Code == codes.OK {
+			write(w, protocol, http.StatusOK, newResponse(r))
+			return
+		}
+		if r.RetryAfter > 0 {
+			seconds := (r.RetryAfter + time.Second - 1) / time.Second
+			w.Header().Set("Retry-After", strconv.FormatInt(int64(seconds), 10))
+		}
+		write(w, protocol, r.httpStatus(), r.status().Proto())
+	})
+}
+
+// decode decodes the body of req into msg.
+func decode(req *http.Request, protocol Protocol, msg proto.Message) error {
+	body := req.Body
+	switch enc := req.Header.Get("Content-Encoding"); enc {
+	case "", "identity":
+	case "gzip":
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return fmt.Errorf("invalid gzip body: %w", err)
+		}
+		defer gz.Close()
+		body = gz
+	default:
+		return fmt.Errorf("unsupported content encoding %q", enc)
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("failed to read body: %w", err)
+	}
+	if protocol == ProtocolHTTPJSON {
+		err = protojson.Unmarshal(data, msg)
+	} else {
+		err = proto.Unmarshal(data, msg)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s body: %w", protocol, err)
+	}
+	return nil
+}
+
+// write writes msg encoded for protocol with the HTTP status code to w.
+func write(w http.ResponseWriter, protocol Protocol, code int, msg proto.Message) {
+	var (
+		data []byte
+		err  error
+	)
+	if protocol == ProtocolHTTPJSON {
+		w.Header().Set("Content-Type", contentTypeJSON)
+		data, err = protojson.Marshal(msg)
+	} else {
+		w.Header().Set("Content-Type", contentTypeProtobuf)
+		data, err = proto.Marshal(msg)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
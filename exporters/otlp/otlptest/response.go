@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptest
+
+import (
+	"net/http"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Response is the response of a [Collector] to an export request.
+//
+// The zero value is a successful response.
+type Response struct {
+	// Delay is the time the Collector waits before it responds. The wait ends
+	// early if the request is canceled.
+	Delay time.Duration
+
+	// Code is the gRPC status code of the response. If it is not codes.OK,
+	// the export fails. Over HTTP, the export fails with the HTTP status code
+	// corresponding to Code as defined by the OTLP specification.
+	Code codes.Code
+	// Message is the error message of a failed export.
+	Message string
+	// RetryAfter is the time the client is asked to wait before it retries a
+	// failed export. It is sent as RetryInfo over gRPC and as Retry-After
+	// header, rounded up to full seconds, over HTTP. It is ignored if zero.
+	RetryAfter time.Duration
+
+	// Rejected is the number of spans, data points, or log records rejected
+	// by a successful export. If it is not zero or PartialSuccessMessage is
+	// not empty, the export is a partial success.
+	Rejected int64
+	// PartialSuccessMessage is the error message of a partial success.
+	PartialSuccessMessage string
+}
+
+// partialSuccess reports whether r is a partial success.
+func (r Response) partialSuccess() bool {
+	return r.Rejected != 0 || r.PartialSuccessMessage != ""
+}
+
+// status returns the gRPC status of a failed export.
+func (r Response) status() *status.Status {
+	s := status.New(r.Code, r.Message)
+	if r.RetryAfter > 0 {
+		if withRetry, err := s.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(r.RetryAfter),
+		}); err == nil {
+			s = withRetry
+		}
+	}
+	return s
+}
+
+// httpStatus returns the HTTP status code of r.
+func (r Response) httpStatus() int {
+	switch r.Code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Aborted:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -323,59 +324,6 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	}
 }
 
-// downscaleExponentialBucket re-aggregates bucket counts when downscaling to a coarser resolution.
-func downscaleExponentialBucket(bucket metricdata.ExponentialBucket, scaleDelta int32) metricdata.ExponentialBucket {
-	if len(bucket.Counts) == 0 || scaleDelta < 1 {
-		return metricdata.ExponentialBucket{
-			Offset: bucket.Offset >> scaleDelta,
-			Counts: append([]uint64(nil), bucket.Counts...), // copy slice
-		}
-	}
-
-	// The new offset is scaled down
-	newOffset := bucket.Offset >> scaleDelta
-
-	// Pre-calculate the new bucket count to avoid growing slice
-	// Each group of 2^scaleDelta buckets will merge into one bucket
-	//nolint:gosec // Length is bounded by slice allocation
-	lastBucketIdx := bucket.Offset + int32(len(bucket.Counts)) - 1
-	lastNewIdx := lastBucketIdx >> scaleDelta
-	newBucketCount := int(lastNewIdx - newOffset + 1)
-
-	if newBucketCount <= 0 {
-		return metricdata.ExponentialBucket{
-			Offset: newOffset,
-			Counts: []uint64{},
-		}
-	}
-
-	newCounts := make([]uint64, newBucketCount)
-
-	// Merge buckets according to the scale difference
-	for i, count := range bucket.Counts {
-		if count == 0 {
-			continue
-		}
-
-		// Calculate which new bucket this count belongs to
-		//nolint:gosec // Index is bounded by loop iteration
-		originalIdx := bucket.Offset + int32(i)
-		newIdx := originalIdx >> scaleDelta
-
-		// Calculate the position in the new counts array
-		position := newIdx - newOffset
-		//nolint:gosec // Length is bounded by allocation
-		if position >= 0 && position < int32(len(newCounts)) {
-			newCounts[position] += count
-		}
-	}
-
-	return metricdata.ExponentialBucket{
-		Offset: newOffset,
-		Counts: newCounts,
-	}
-}
-
 func addExponentialHistogramMetric[N int64 | float64](
 	ctx context.Context,
 	ch chan<- prometheus.Metric,
@@ -423,8 +371,8 @@ func addExponentialHistogramMetric[N int64 | float64](
 		negativeBucket := dp.NegativeBucket
 		if scale > 8 {
 			scaleDelta := scale - 8
-			positiveBucket = downscaleExponentialBucket(dp.PositiveBucket, scaleDelta)
-			negativeBucket = downscaleExponentialBucket(dp.NegativeBucket, scaleDelta)
+			positiveBucket = metricdataops.DownscaleExponentialBucket(dp.PositiveBucket, scaleDelta)
+			negativeBucket = metricdataops.DownscaleExponentialBucket(dp.NegativeBucket, scaleDelta)
 			scale = 8
 		}
 
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package x contains experimental helpers for the OpenTelemetry Logs API.
+//
+// These helpers are under development and not part of the stable API. They
+// may be changed in backwards-incompatible ways, or removed entirely.
+package x // import "go.opentelemetry.io/otel/log/x"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+var (
+	// ErrMissingAttribute is returned when an event is emitted without one of
+	// its required attributes.
+	ErrMissingAttribute = errors.New("missing required event attribute")
+	// ErrAttributeType is returned when an event is emitted with an attribute
+	// of a type different from the one of its definition.
+	ErrAttributeType = errors.New("invalid event attribute type")
+)
+
+// Event is the definition of an event: a log record with a fixed event name,
+// a severity, and a schema of attributes.
+//
+// The attributes of an event are declared with [WithAttribute] and
+// [WithRequiredAttribute]. Emitting an event without one of its required
+// attributes, or with a declared attribute of another type, fails. Attributes
+// that are not declared are emitted as is.
+//
+// Use [NewEvent] to create an Event. An Event is safe for concurrent use.
+type Event struct {
+	name     string
+	severity log.Severity
+	fields   []field
+}
+
+type field struct {
+	key      attribute.Key
+	typ      attribute.Type
+	required bool
+}
+
+// NewEvent returns a new Event with the event name name.
+func NewEvent(name string, opts ...EventOption) *Event {
+	cfg := eventConfig{severity: log.SeverityInfo}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &Event{name: name, severity: cfg.severity, fields: cfg.fields}
+}
+
+// Name returns the event name of e.
+func (e *Event) Name() string {
+	return e.name
+}
+
+// Severity returns the default severity of e.
+func (e *Event) Severity() log.Severity {
+	return e.severity
+}
+
+// Enabled reports whether logger emits e for ctx.
+func (e *Event) Enabled(ctx context.Context, logger log.Logger) bool {
+	return logger.Enabled(ctx, log.EnabledParameters{Severity: e.severity, EventName: e.name})
+}
+
+// Emit emits e with payload using logger, if logger is enabled for it.
+//
+// The log record emitted has the event name of e, the body and attributes of
+// payload, and the timestamp of payload, or the current time if it is zero.
+// Its severity is the severity of payload, or the severity of e if it is
+// undefined.
+//
+// An error wrapping [ErrMissingAttribute] or [ErrAttributeType] is returned,
+// and nothing is emitted, if the attributes of payload do not match the
+// schema of e. The attributes are validated even if logger is not enabled for
+// e, so mismatches are found regardless of the configuration of logger.
+func (e *Event) Emit(ctx context.Context, logger log.Logger, payload Payload) error {
+	if err := e.validate(payload.Attributes); err != nil {
+		return err
+	}
+	severity := payload.Severity
+	if severity == log.SeverityUndefined {
+		severity = e.severity
+	}
+	if !logger.Enabled(ctx, log.EnabledParameters{Severity: severity, EventName: e.name}) {
+		return nil
+	}
+
+	ts := payload.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	var r log.Record
+	r.SetEventName(e.name)
+	r.SetTimestamp(ts)
+	r.SetSeverity(severity)
+	r.SetBody(payload.Body)
+	r.AddAttributes(payload.Attributes...)
+	logger.Emit(ctx, r)
+	return nil
+}
+
+// validate returns an error if attrs do not match the schema of e.
+func (e *Event) validate(attrs []attribute.KeyValue) error {
+	var errs []error
+	for _, f := range e.fields {
+		found := false
+		for _, kv := range attrs {
+			if kv.Key != f.key {
+				continue
+			}
+			found = true
+			if kv.Value.Type() != f.typ {
+				errs = append(errs, fmt.Errorf(
+					"%w: event %s: %s is %s, want %s", ErrAttributeType, e.name, f.key, kv.Value.Type(), f.typ,
+				))
+			}
+		}
+		if f.required && !found {
+			errs = append(errs, fmt.Errorf("%w: event %s: %s", ErrMissingAttribute, e.name, f.key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Payload is the content of an emitted [Event].
+type Payload struct {
+	// Timestamp is the time the event occurred. The current time is used if
+	// it is zero.
+	Timestamp time.Time
+	// Severity is the severity of the event. The severity of the [Event] is
+	// used if it is undefined.
+	Severity log.Severity
+	// Body is the body of the event. Use [attribute.MapValue] for a
+	// structured body.
+	Body attribute.Value
+	// Attributes are the attributes of the event.
+	Attributes []attribute.KeyValue
+}
+
+// TypedEvent is an [Event] emitted from values of type T.
+//
+// Use [TypedEvent.Enabled] to avoid computing values if the event is not
+// enabled with its default severity.
+//
+// Use [NewTypedEvent] to create a TypedEvent.
+type TypedEvent[T any] struct {
+	event  *Event
+	encode func(T) Payload
+}
+
+// NewTypedEvent returns a new TypedEvent that emits event with the payloads
+// returned by encode.
+func NewTypedEvent[T any](event *Event, encode func(T) Payload) *TypedEvent[T] {
+	return &TypedEvent[T]{event: event, encode: encode}
+}
+
+// Event returns the definition of e.
+func (e *TypedEvent[T]) Event() *Event {
+	return e.event
+}
+
+// Enabled reports whether logger emits e for ctx.
+func (e *TypedEvent[T]) Enabled(ctx context.Context, logger log.Logger) bool {
+	return e.event.Enabled(ctx, logger)
+}
+
+// Emit emits e with the payload encoded from v using logger, if logger is
+// enabled for e with the severity of the payload. See [Event.Emit] for the
+// log record emitted and the errors returned.
+//
+// The value is always encoded, as the payload can set a severity other than
+// the default one of the event.
+func (e *TypedEvent[T]) Emit(ctx context.Context, logger log.Logger, v T) error {
+	return e.event.Emit(ctx, logger, e.encode(v))
+}
+
+type eventConfig struct {
+	severity log.Severity
+	fields   []field
+}
+
+// EventOption applies a configuration to an [Event].
+type EventOption interface {
+	apply(eventConfig) eventConfig
+}
+
+type eventOptionFunc func(eventConfig) eventConfig
+
+func (fn eventOptionFunc) apply(c eventConfig) eventConfig {
+	return fn(c)
+}
+
+// WithSeverity sets the default severity of an [Event].
+//
+// By default, [log.SeverityInfo] is used. The default value is also used when
+// the provided value is undefined.
+func WithSeverity(severity log.Severity) EventOption {
+	return eventOptionFunc(func(cfg eventConfig) eventConfig {
+		if severity != log.SeverityUndefined {
+			cfg.severity = severity
+		}
+		return cfg
+	})
+}
+
+// WithAttribute declares an optional attribute of an [Event] with the key and
+// the type typ.
+func WithAttribute(key attribute.Key, typ attribute.Type) EventOption {
+	return withField(field{key: key, typ: typ})
+}
+
+// WithRequiredAttribute declares a required attribute of an [Event] with the
+// key and the type typ.
+func WithRequiredAttribute(key attribute.Key, typ attribute.Type) EventOption {
+	return withField(field{key: key, typ: typ, required: true})
+}
+
+func withField(f field) EventOption {
+	return eventOptionFunc(func(cfg eventConfig) eventConfig {
+		cfg.fields = append(cfg.fields, f)
+		return cfg
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+type logger struct {
+	embedded.Logger
+
+	minSeverity log.Severity
+	params      []log.EnabledParameters
+	records     []log.Record
+}
+
+func (l *logger) Emit(_ context.Context, r log.Record) {
+	l.records = append(l.records, r)
+}
+
+func (l *logger) Enabled(_ context.Context, param log.EnabledParameters) bool {
+	l.params = append(l.params, param)
+	return param.Severity >= l.minSeverity
+}
+
+func attrs(r log.Record) []attribute.KeyValue {
+	var kvs []attribute.KeyValue
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		kvs = append(kvs, kv)
+		return true
+	})
+	return kvs
+}
+
+var login = NewEvent(
+	"user.login",
+	WithRequiredAttribute("user.id", attribute.STRING),
+	WithAttribute("user.attempts", attribute.INT64),
+)
+
+func TestEventEmit(t *testing.T) {
+	l := new(logger)
+	ts := time.Unix(10, 0)
+	body := attribute.MapValue(attribute.String("method", "password"))
+	payload := Payload{
+		Timestamp:  ts,
+		Body:       body,
+		Attributes: []attribute.KeyValue{attribute.String("user.id", "alice"), attribute.Bool("extra", true)},
+	}
+	require.NoError(t, login.Emit(t.Context(), l, payload))
+
+	require.Len(t, l.params, 1)
+	assert.Equal(t, log.EnabledParameters{Severity: log.SeverityInfo, EventName: "user.login"}, l.params[0])
+
+	require.Len(t, l.records, 1)
+	r := l.records[0]
+	assert.Equal(t, "user.login", r.EventName())
+	assert.Equal(t, ts, r.Timestamp())
+	assert.Equal(t, log.SeverityInfo, r.Severity())
+	assert.Equal(t, body, r.Body())
+	assert.Equal(t, payload.Attributes, attrs(r))
+}
+
+func TestEventEmitDefaults(t *testing.T) {
+	l := new(logger)
+	e := NewEvent("event", WithSeverity(log.SeverityWarn))
+	assert.Equal(t, "event", e.Name())
+	assert.Equal(t, log.SeverityWarn, e.Severity())
+
+	before := time.Now()
+	require.NoError(t, e.Emit(t.Context(), l, Payload{}))
+	require.NoError(t, e.Emit(t.Context(), l, Payload{Severity: log.SeverityError}))
+
+	require.Len(t, l.records, 2)
+	assert.False(t, l.records[0].Timestamp().Before(before), "current time")
+	assert.Equal(t, log.SeverityWarn, l.records[0].Severity())
+	assert.Equal(t, log.SeverityError, l.records[1].Severity())
+	assert.Equal(t, log.SeverityError, l.params[1].Severity)
+
+	assert.Equal(t, log.SeverityInfo, NewEvent("event", WithSeverity(log.SeverityUndefined)).Severity())
+}
+
+func TestEventEmitInvalid(t *testing.T) {
+	l := new(logger)
+
+	err := login.Emit(t.Context(), l, Payload{})
+	assert.ErrorIs(t, err, ErrMissingAttribute)
+	assert.ErrorContains(t, err, "user.id")
+
+	err = login.Emit(t.Context(), l, Payload{Attributes: []attribute.KeyValue{
+		attribute.Int("user.id", 1),
+		attribute.String("user.attempts", "3"),
+	}})
+	assert.ErrorIs(t, err, ErrAttributeType)
+	assert.NotErrorIs(t, err, ErrMissingAttribute)
+	assert.ErrorContains(t, err, "user.id is INT64, want STRING")
+	assert.ErrorContains(t, err, "user.attempts is STRING, want INT64")
+
+	assert.Empty(t, l.records)
+}
+
+func TestEventDisabled(t *testing.T) {
+	l := &logger{minSeverity: log.SeverityWarn}
+	assert.False(t, login.Enabled(t.Context(), l))
+
+	valid := []attribute.KeyValue{attribute.String("user.id", "alice")}
+	require.NoError(t, login.Emit(t.Context(), l, Payload{Attributes: valid}))
+	assert.Empty(t, l.records)
+
+	// Validated even though nothing is emitted.
+	assert.ErrorIs(t, login.Emit(t.Context(), l, Payload{}), ErrMissingAttribute)
+	err := login.Emit(t.Context(), l, Payload{Attributes: []attribute.KeyValue{attribute.Int("user.id", 1)}})
+	assert.ErrorIs(t, err, ErrAttributeType)
+	assert.Empty(t, l.records)
+
+	require.NoError(t, login.Emit(t.Context(), l, Payload{Severity: log.SeverityWarn, Attributes: valid}))
+	assert.Len(t, l.records, 1)
+}
+
+type loginInfo struct {
+	User     string
+	Attempts int
+}
+
+func TestTypedEvent(t *testing.T) {
+	e := NewTypedEvent(login, func(v loginInfo) Payload {
+		return Payload{Attributes: []attribute.KeyValue{
+			attribute.String("user.id", v.User),
+			attribute.Int("user.attempts", v.Attempts),
+		}}
+	})
+	assert.Same(t, login, e.Event())
+
+	l := new(logger)
+	assert.True(t, e.Enabled(t.Context(), l))
+	require.NoError(t, e.Emit(t.Context(), l, loginInfo{User: "alice", Attempts: 2}))
+	require.Len(t, l.records, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("user.id", "alice"),
+		attribute.Int("user.attempts", 2),
+	}, attrs(l.records[0]))
+
+	disabled := &logger{minSeverity: log.SeverityError}
+	assert.False(t, e.Enabled(t.Context(), disabled))
+	require.NoError(t, e.Emit(t.Context(), disabled, loginInfo{User: "bob"}))
+	assert.Empty(t, disabled.records)
+}
+
+func TestTypedEventPayloadSeverity(t *testing.T) {
+	e := NewTypedEvent(login, func(v loginInfo) Payload {
+		p := Payload{Attributes: []attribute.KeyValue{attribute.String("user.id", v.User)}}
+		if v.Attempts > 3 {
+			p.Severity = log.SeverityError
+		}
+		return p
+	})
+
+	l := &logger{minSeverity: log.SeverityError}
+	require.NoError(t, e.Emit(t.Context(), l, loginInfo{User: "alice", Attempts: 1}))
+	assert.Empty(t, l.records)
+	require.NoError(t, e.Emit(t.Context(), l, loginInfo{User: "bob", Attempts: 5}))
+	require.Len(t, l.records, 1, "payload severity above the event default")
+	assert.Equal(t, log.SeverityError, l.records[0].Severity())
+}
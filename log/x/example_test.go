@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x_test
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/x"
+)
+
+// Order is a business object reported as an event.
+type Order struct {
+	ID     string
+	Amount float64
+	Items  []string
+}
+
+// orderPlaced is the definition of the event emitted when an order is placed.
+var orderPlaced = x.NewTypedEvent(
+	x.NewEvent(
+		"shop.order.placed",
+		x.WithRequiredAttribute("shop.order.id", attribute.STRING),
+		x.WithAttribute("shop.order.amount", attribute.FLOAT64),
+	),
+	func(o Order) x.Payload {
+		return x.Payload{
+			Body: attribute.MapValue(attribute.StringSlice("items", o.Items)),
+			Attributes: []attribute.KeyValue{
+				attribute.String("shop.order.id", o.ID),
+				attribute.Float64("shop.order.amount", o.Amount),
+			},
+		}
+	},
+)
+
+func Example() {
+	ctx := context.Background()
+	logger := global.GetLoggerProvider().Logger("example.com/shop")
+
+	// Check whether the logger is enabled for the event to avoid building the
+	// order when it is not.
+	if orderPlaced.Enabled(ctx, logger) {
+		order := Order{ID: "42", Amount: 9.99, Items: []string{"book"}}
+		if err := orderPlaced.Emit(ctx, logger, order); err != nil {
+			// The payload does not match the definition of the event.
+			panic(err)
+		}
+	}
+
+	// Events without a typed payload are emitted with their definition.
+	cancelled := x.NewEvent("shop.order.cancelled", x.WithSeverity(log.SeverityWarn))
+	_ = cancelled.Emit(ctx, logger, x.Payload{
+		Attributes: []attribute.KeyValue{attribute.String("shop.order.id", "42")},
+	})
+}
@@ -0,0 +1,276 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Int64Bindable is an optional interface implemented by int64 synchronous
+// instruments that can bind their measurements to an attribute set.
+//
+// Bind returns a function that records measurements with attrs. The
+// implementation is expected to do any attribute processing once, when Bind
+// is called, so recording through the returned function is cheaper than
+// passing attrs to the instrument for every measurement.
+type Int64Bindable interface {
+	Bind(attrs attribute.Set) func(ctx context.Context, value int64)
+}
+
+// Float64Bindable is an optional interface implemented by float64 synchronous
+// instruments that can bind their measurements to an attribute set.
+//
+// Bind returns a function that records measurements with attrs. The
+// implementation is expected to do any attribute processing once, when Bind
+// is called, so recording through the returned function is cheaper than
+// passing attrs to the instrument for every measurement.
+type Float64Bindable interface {
+	Bind(attrs attribute.Set) func(ctx context.Context, value float64)
+}
+
+// bindInt64 returns the bound record function of inst if it implements
+// Int64Bindable, otherwise fallback is returned.
+func bindInt64(inst any, attrs attribute.Set, fallback func(context.Context, int64)) func(context.Context, int64) {
+	if b, ok := inst.(Int64Bindable); ok {
+		return b.Bind(attrs)
+	}
+	return fallback
+}
+
+// bindFloat64 returns the bound record function of inst if it implements
+// Float64Bindable, otherwise fallback is returned.
+func bindFloat64(
+	inst any,
+	attrs attribute.Set,
+	fallback func(context.Context, float64),
+) func(context.Context, float64) {
+	if b, ok := inst.(Float64Bindable); ok {
+		return b.Bind(attrs)
+	}
+	return fallback
+}
+
+// BoundInt64Counter is an [metric.Int64Counter] bound to an attribute set.
+//
+// The zero value performs no operation. Use [BindInt64Counter] to create a
+// BoundInt64Counter.
+type BoundInt64Counter struct {
+	add func(context.Context, int64)
+}
+
+// BindInt64Counter returns counter bound to attrs. All increments made with
+// the returned BoundInt64Counter are recorded with attrs.
+//
+// If counter does not implement [Int64Bindable], increments are recorded
+// with counter.Add and a [metric.WithAttributeSet] option created once.
+func BindInt64Counter(counter metric.Int64Counter, attrs attribute.Set) BoundInt64Counter {
+	opts := []metric.AddOption{metric.WithAttributeSet(attrs)}
+	return BoundInt64Counter{add: bindInt64(counter, attrs, func(ctx context.Context, incr int64) {
+		counter.Add(ctx, incr, opts...)
+	})}
+}
+
+// Add records a change to the counter.
+func (c BoundInt64Counter) Add(ctx context.Context, incr int64) {
+	if c.add != nil {
+		c.add(ctx, incr)
+	}
+}
+
+// BoundInt64UpDownCounter is an [metric.Int64UpDownCounter] bound to an
+// attribute set.
+//
+// The zero value performs no operation. Use [BindInt64UpDownCounter] to create
+// a BoundInt64UpDownCounter.
+type BoundInt64UpDownCounter struct {
+	add func(context.Context, int64)
+}
+
+// BindInt64UpDownCounter returns counter bound to attrs. All increments made
+// with the returned BoundInt64UpDownCounter are recorded with attrs.
+//
+// If counter does not implement [Int64Bindable], increments are recorded
+// with counter.Add and a [metric.WithAttributeSet] option created once.
+func BindInt64UpDownCounter(counter metric.Int64UpDownCounter, attrs attribute.Set) BoundInt64UpDownCounter {
+	opts := []metric.AddOption{metric.WithAttributeSet(attrs)}
+	return BoundInt64UpDownCounter{add: bindInt64(counter, attrs, func(ctx context.Context, incr int64) {
+		counter.Add(ctx, incr, opts...)
+	})}
+}
+
+// Add records a change to the counter.
+func (c BoundInt64UpDownCounter) Add(ctx context.Context, incr int64) {
+	if c.add != nil {
+		c.add(ctx, incr)
+	}
+}
+
+// BoundInt64Histogram is an [metric.Int64Histogram] bound to an attribute
+// set.
+//
+// The zero value performs no operation. Use [BindInt64Histogram] to create a
+// BoundInt64Histogram.
+type BoundInt64Histogram struct {
+	record func(context.Context, int64)
+}
+
+// BindInt64Histogram returns histogram bound to attrs. All values recorded
+// with the returned BoundInt64Histogram are recorded with attrs.
+//
+// If histogram does not implement [Int64Bindable], values are recorded with
+// histogram.Record and a [metric.WithAttributeSet] option created once.
+func BindInt64Histogram(histogram metric.Int64Histogram, attrs attribute.Set) BoundInt64Histogram {
+	opts := []metric.RecordOption{metric.WithAttributeSet(attrs)}
+	return BoundInt64Histogram{record: bindInt64(histogram, attrs, func(ctx context.Context, value int64) {
+		histogram.Record(ctx, value, opts...)
+	})}
+}
+
+// Record adds an additional value to the distribution.
+func (h BoundInt64Histogram) Record(ctx context.Context, value int64) {
+	if h.record != nil {
+		h.record(ctx, value)
+	}
+}
+
+// BoundInt64Gauge is an [metric.Int64Gauge] bound to an attribute set.
+//
+// The zero value performs no operation. Use [BindInt64Gauge] to create a
+// BoundInt64Gauge.
+type BoundInt64Gauge struct {
+	record func(context.Context, int64)
+}
+
+// BindInt64Gauge returns gauge bound to attrs. All values recorded with the
+// returned BoundInt64Gauge are recorded with attrs.
+//
+// If gauge does not implement [Int64Bindable], values are recorded with
+// gauge.Record and a [metric.WithAttributeSet] option created once.
+func BindInt64Gauge(gauge metric.Int64Gauge, attrs attribute.Set) BoundInt64Gauge {
+	opts := []metric.RecordOption{metric.WithAttributeSet(attrs)}
+	return BoundInt64Gauge{record: bindInt64(gauge, attrs, func(ctx context.Context, value int64) {
+		gauge.Record(ctx, value, opts...)
+	})}
+}
+
+// Record records the instantaneous value.
+func (g BoundInt64Gauge) Record(ctx context.Context, value int64) {
+	if g.record != nil {
+		g.record(ctx, value)
+	}
+}
+
+// BoundFloat64Counter is an [metric.Float64Counter] bound to an attribute
+// set.
+//
+// The zero value performs no operation. Use [BindFloat64Counter] to create a
+// BoundFloat64Counter.
+type BoundFloat64Counter struct {
+	add func(context.Context, float64)
+}
+
+// BindFloat64Counter returns counter bound to attrs. All increments made with
+// the returned BoundFloat64Counter are recorded with attrs.
+//
+// If counter does not implement [Float64Bindable], increments are recorded
+// with counter.Add and a [metric.WithAttributeSet] option created once.
+func BindFloat64Counter(counter metric.Float64Counter, attrs attribute.Set) BoundFloat64Counter {
+	opts := []metric.AddOption{metric.WithAttributeSet(attrs)}
+	return BoundFloat64Counter{add: bindFloat64(counter, attrs, func(ctx context.Context, incr float64) {
+		counter.Add(ctx, incr, opts...)
+	})}
+}
+
+// Add records a change to the counter.
+func (c BoundFloat64Counter) Add(ctx context.Context, incr float64) {
+	if c.add != nil {
+		c.add(ctx, incr)
+	}
+}
+
+// BoundFloat64UpDownCounter is an [metric.Float64UpDownCounter] bound to an
+// attribute set.
+//
+// The zero value performs no operation. Use [BindFloat64UpDownCounter] to
+// create a BoundFloat64UpDownCounter.
+type BoundFloat64UpDownCounter struct {
+	add func(context.Context, float64)
+}
+
+// BindFloat64UpDownCounter returns counter bound to attrs. All increments
+// made with the returned BoundFloat64UpDownCounter are recorded with attrs.
+//
+// If counter does not implement [Float64Bindable], increments are recorded
+// with counter.Add and a [metric.WithAttributeSet] option created once.
+func BindFloat64UpDownCounter(counter metric.Float64UpDownCounter, attrs attribute.Set) BoundFloat64UpDownCounter {
+	opts := []metric.AddOption{metric.WithAttributeSet(attrs)}
+	return BoundFloat64UpDownCounter{add: bindFloat64(counter, attrs, func(ctx context.Context, incr float64) {
+		counter.Add(ctx, incr, opts...)
+	})}
+}
+
+// Add records a change to the counter.
+func (c BoundFloat64UpDownCounter) Add(ctx context.Context, incr float64) {
+	if c.add != nil {
+		c.add(ctx, incr)
+	}
+}
+
+// BoundFloat64Histogram is an [metric.Float64Histogram] bound to an attribute
+// set.
+//
+// The zero value performs no operation. Use [BindFloat64Histogram] to create a
+// BoundFloat64Histogram.
+type BoundFloat64Histogram struct {
+	record func(context.Context, float64)
+}
+
+// BindFloat64Histogram returns histogram bound to attrs. All values recorded
+// with the returned BoundFloat64Histogram are recorded with attrs.
+//
+// If histogram does not implement [Float64Bindable], values are recorded with
+// histogram.Record and a [metric.WithAttributeSet] option created once.
+func BindFloat64Histogram(histogram metric.Float64Histogram, attrs attribute.Set) BoundFloat64Histogram {
+	opts := []metric.RecordOption{metric.WithAttributeSet(attrs)}
+	return BoundFloat64Histogram{record: bindFloat64(histogram, attrs, func(ctx context.Context, value float64) {
+		histogram.Record(ctx, value, opts...)
+	})}
+}
+
+// Record adds an additional value to the distribution.
+func (h BoundFloat64Histogram) Record(ctx context.Context, value float64) {
+	if h.record != nil {
+		h.record(ctx, value)
+	}
+}
+
+// BoundFloat64Gauge is an [metric.Float64Gauge] bound to an attribute set.
+//
+// The zero value performs no operation. Use [BindFloat64Gauge] to create a
+// BoundFloat64Gauge.
+type BoundFloat64Gauge struct {
+	record func(context.Context, float64)
+}
+
+// BindFloat64Gauge returns gauge bound to attrs. All values recorded with the
+// returned BoundFloat64Gauge are recorded with attrs.
+//
+// If gauge does not implement [Float64Bindable], values are recorded with
+// gauge.Record and a [metric.WithAttributeSet] option created once.
+func BindFloat64Gauge(gauge metric.Float64Gauge, attrs attribute.Set) BoundFloat64Gauge {
+	opts := []metric.RecordOption{metric.WithAttributeSet(attrs)}
+	return BoundFloat64Gauge{record: bindFloat64(gauge, attrs, func(ctx context.Context, value float64) {
+		gauge.Record(ctx, value, opts...)
+	})}
+}
+
+// Record records the instantaneous value.
+func (g BoundFloat64Gauge) Record(ctx context.Context, value float64) {
+	if g.record != nil {
+		g.record(ctx, value)
+	}
+}
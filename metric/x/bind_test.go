@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type testCounter struct {
+	noop.Int64Counter
+
+	value int64
+	attrs attribute.Set
+}
+
+func (c *testCounter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	c.value += incr
+	c.attrs = metric.NewAddConfig(opts).Attributes()
+}
+
+type testBindableCounter struct {
+	testCounter
+
+	bound attribute.Set
+}
+
+func (c *testBindableCounter) Bind(attrs attribute.Set) func(context.Context, int64) {
+	c.bound = attrs
+	return func(_ context.Context, incr int64) { c.value += incr }
+}
+
+func TestBindInt64CounterFallback(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("a", "b"))
+	c := new(testCounter)
+	bound := BindInt64Counter(c, attrs)
+
+	bound.Add(t.Context(), 1)
+	bound.Add(t.Context(), 2)
+
+	if c.value != 3 {
+		t.Errorf("expected value 3, got %d", c.value)
+	}
+	if !c.attrs.Equals(&attrs) {
+		t.Errorf("expected attributes %v, got %v", attrs, c.attrs)
+	}
+}
+
+func TestBindInt64CounterBindable(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("a", "b"))
+	c := new(testBindableCounter)
+	bound := BindInt64Counter(c, attrs)
+
+	bound.Add(t.Context(), 1)
+	bound.Add(t.Context(), 2)
+
+	if c.value != 3 {
+		t.Errorf("expected value 3, got %d", c.value)
+	}
+	if !c.bound.Equals(&attrs) {
+		t.Errorf("expected bound attributes %v, got %v", attrs, c.bound)
+	}
+	if c.attrs.Len() != 0 {
+		t.Errorf("expected Add not to be called, got attributes %v", c.attrs)
+	}
+}
+
+func TestBindNoop(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("a", "b"))
+	ctx := t.Context()
+
+	// None of the noop instruments implement Bind, ensure the fallback works.
+	BindInt64Counter(noop.Int64Counter{}, attrs).Add(ctx, 1)
+	BindInt64UpDownCounter(noop.Int64UpDownCounter{}, attrs).Add(ctx, 1)
+	BindInt64Histogram(noop.Int64Histogram{}, attrs).Record(ctx, 1)
+	BindInt64Gauge(noop.Int64Gauge{}, attrs).Record(ctx, 1)
+	BindFloat64Counter(noop.Float64Counter{}, attrs).Add(ctx, 1)
+	BindFloat64UpDownCounter(noop.Float64UpDownCounter{}, attrs).Add(ctx, 1)
+	BindFloat64Histogram(noop.Float64Histogram{}, attrs).Record(ctx, 1)
+	BindFloat64Gauge(noop.Float64Gauge{}, attrs).Record(ctx, 1)
+}
+
+func TestBoundZeroValue(t *testing.T) {
+	ctx := t.Context()
+
+	// The zero values must not panic.
+	BoundInt64Counter{}.Add(ctx, 1)
+	BoundInt64UpDownCounter{}.Add(ctx, 1)
+	BoundInt64Histogram{}.Record(ctx, 1)
+	BoundInt64Gauge{}.Record(ctx, 1)
+	BoundFloat64Counter{}.Add(ctx, 1)
+	BoundFloat64UpDownCounter{}.Add(ctx, 1)
+	BoundFloat64Histogram{}.Record(ctx, 1)
+	BoundFloat64Gauge{}.Record(ctx, 1)
+}
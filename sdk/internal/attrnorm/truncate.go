@@ -49,7 +49,7 @@ func Truncate(limit int, attr attribute.KeyValue) attribute.KeyValue {
 		}
 		newV := make([]attribute.Value, len(v))
 		for i, elem := range v {
-			newV[i] = TruncateValue(limit, elem)
+			newV[i], _ = TruncateValue(limit, elem)
 		}
 		return attr.Key.Slice(newV...)
 	case attribute.MAP:
@@ -59,7 +59,7 @@ func Truncate(limit int, attr attribute.KeyValue) attribute.KeyValue {
 		}
 		newV := make([]attribute.KeyValue, len(v))
 		for i, elem := range v {
-			elem.Value = TruncateValue(limit, elem.Value)
+			elem.Value, _ = TruncateValue(limit, elem.Value)
 			newV[i] = elem
 		}
 		return attr.Key.Map(newV...)
@@ -67,54 +67,62 @@ func Truncate(limit int, attr attribute.KeyValue) attribute.KeyValue {
 	return attr
 }
 
-// TruncateValue returns a truncated version of v. Only string, string
-// slice, byte slice, and (recursively) slice and map values are modified.
+// TruncateValue returns a truncated version of v, and whether it was
+// modified. Only string, string slice, byte slice, and (recursively) slice and
+// map values are modified.
 //
 // No truncation is performed for a negative limit.
-func TruncateValue(limit int, v attribute.Value) attribute.Value {
+func TruncateValue(limit int, v attribute.Value) (attribute.Value, bool) {
 	if limit < 0 {
-		return v
+		return v, false
 	}
 
 	switch v.Type() {
 	case attribute.STRING:
-		return attribute.StringValue(truncate(limit, v.AsString()))
+		s := v.AsString()
+		// truncate only ever returns s or a shorter string.
+		if t := truncate(limit, s); len(t) != len(s) {
+			return attribute.StringValue(t), true
+		}
 	case attribute.STRINGSLICE:
 		ss := v.AsStringSlice()
+		if !slices.ContainsFunc(ss, func(s string) bool { return stringNeedsTruncation(limit, s) }) {
+			return v, false
+		}
 		for i := range ss {
 			ss[i] = truncate(limit, ss[i])
 		}
-		return attribute.StringSliceValue(ss)
+		return attribute.StringSliceValue(ss), true
 	case attribute.BYTESLICE:
 		// len(v.AsString()) is identical to len(v.AsByteSlice()) but
 		// avoids allocating the full slice before truncation.
 		s := v.AsString()
-		if limit >= 0 && len(s) > limit {
-			return attribute.ByteSliceValue([]byte(s[:limit]))
+		if len(s) > limit {
+			return attribute.ByteSliceValue([]byte(s[:limit])), true
 		}
 	case attribute.SLICE:
 		sl := v.AsSlice()
 		if !slices.ContainsFunc(sl, func(e attribute.Value) bool { return needsTruncation(limit, e) }) {
-			return v
+			return v, false
 		}
 		newSl := make([]attribute.Value, len(sl))
 		for i, elem := range sl {
-			newSl[i] = TruncateValue(limit, elem)
+			newSl[i], _ = TruncateValue(limit, elem)
 		}
-		return attribute.SliceValue(newSl...)
+		return attribute.SliceValue(newSl...), true
 	case attribute.MAP:
 		m := v.AsMap()
 		if !slices.ContainsFunc(m, func(kv attribute.KeyValue) bool { return needsTruncation(limit, kv.Value) }) {
-			return v
+			return v, false
 		}
 		newM := make([]attribute.KeyValue, len(m))
 		for i, elem := range m {
-			elem.Value = TruncateValue(limit, elem.Value)
+			elem.Value, _ = TruncateValue(limit, elem.Value)
 			newM[i] = elem
 		}
-		return attribute.MapValue(newM...)
+		return attribute.MapValue(newM...), true
 	}
-	return v
+	return v, false
 }
 
 // stringNeedsTruncation reports whether s would be modified by truncate for the
@@ -9,6 +9,7 @@ package attrnorm
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -394,6 +395,7 @@ func TestTruncateValue(t *testing.T) {
 		name        string
 		limit       int
 		value, want attribute.Value
+		truncated   bool
 	}{
 		{
 			name:  "NegativeLimit",
@@ -402,16 +404,51 @@ func TestTruncateValue(t *testing.T) {
 			want:  attribute.StringValue("value"),
 		},
 		{
-			name:  "String",
-			limit: 2,
+			name:      "String",
+			limit:     2,
+			value:     attribute.StringValue("value"),
+			want:      attribute.StringValue("va"),
+			truncated: true,
+		},
+		{
+			name:      "InvalidString",
+			limit:     2,
+			value:     attribute.StringValue("a\xffb"),
+			want:      attribute.StringValue("ab"),
+			truncated: true,
+		},
+		{
+			name:  "UnchangedString",
+			limit: 5,
 			value: attribute.StringValue("value"),
-			want:  attribute.StringValue("va"),
+			want:  attribute.StringValue("value"),
 		},
 		{
-			name:  "Map",
-			limit: 2,
-			value: attribute.MapValue(attribute.String("key", "value")),
-			want:  attribute.MapValue(attribute.String("key", "va")),
+			name:      "StringSlice",
+			limit:     2,
+			value:     attribute.StringSliceValue([]string{"a", "value"}),
+			want:      attribute.StringSliceValue([]string{"a", "va"}),
+			truncated: true,
+		},
+		{
+			name:  "UnchangedStringSlice",
+			limit: 5,
+			value: attribute.StringSliceValue([]string{"a", "value"}),
+			want:  attribute.StringSliceValue([]string{"a", "value"}),
+		},
+		{
+			name:      "ByteSlice",
+			limit:     2,
+			value:     attribute.ByteSliceValue([]byte("value")),
+			want:      attribute.ByteSliceValue([]byte("va")),
+			truncated: true,
+		},
+		{
+			name:      "Map",
+			limit:     2,
+			value:     attribute.MapValue(attribute.String("key", "value")),
+			want:      attribute.MapValue(attribute.String("key", "va")),
+			truncated: true,
 		},
 		{
 			name:  "UnchangedMap",
@@ -423,9 +460,16 @@ func TestTruncateValue(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.want, TruncateValue(test.limit, test.value))
+			got, truncated := TruncateValue(test.limit, test.value)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.truncated, truncated)
 		})
 	}
+
+	// NaN values are not equal to themselves, but are not truncated.
+	got, truncated := TruncateValue(0, attribute.Float64SliceValue([]float64{math.NaN()}))
+	assert.Equal(t, attribute.FLOAT64SLICE, got.Type())
+	assert.False(t, truncated)
 }
 
 func TestTruncateString(t *testing.T) {
@@ -10,3 +10,5 @@ package internal
 //go:generate gotmpl --body=../../internal/shared/attrnorm/dedup_test.go.tmpl "--data={}" --out=attrnorm/dedup_test.go
 //go:generate gotmpl --body=../../internal/shared/attrnorm/truncate.go.tmpl "--data={}" --out=attrnorm/truncate.go
 //go:generate gotmpl --body=../../internal/shared/attrnorm/truncate_test.go.tmpl "--data={}" --out=attrnorm/truncate_test.go
+//go:generate gotmpl --body=../../internal/shared/protosize/size.go.tmpl "--data={}" --out=protosize/size.go
+//go:generate gotmpl --body=../../internal/shared/protosize/size_test.go.tmpl "--data={}" --out=protosize/size_test.go
@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/log/internal/counter"
 	"go.opentelemetry.io/otel/sdk/log/internal/observ"
 )
@@ -88,8 +89,10 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 		exporter = defaultNoopExporter
 	}
 
+	q := newQueue(cfg.maxQSize.Value)
+	q.priority = cfg.priority
 	b := &BatchProcessor{
-		q:             newQueue(cfg.maxQSize.Value),
+		q:             q,
 		batchSize:     cfg.expMaxBatchSize.Value,
 		exportTrigger: make(chan struct{}, 1),
 		flush:         make(chan batchProcessorRequest),
@@ -120,7 +123,7 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 	exporter = newTimeoutExporter(exporter, cfg.expTimeout.Value)
 	// Use a chunkExporter to ensure ForceFlush and Shutdown calls are batched
 	// appropriately on export.
-	exporter = newChunkExporter(exporter, cfg.expMaxBatchSize.Value)
+	exporter = newChunkExporter(exporter, cfg.expMaxBatchSize.Value, cfg.expMaxBatchBytes)
 
 	b.exporter = exporter
 	b.process(cfg.expInterval.Value)
@@ -251,7 +254,13 @@ func (b *BatchProcessor) logDroppedRecords() {
 		if b.inst != nil {
 			b.inst.ProcessedQueueFull(context.Background(), int64(min(math.MaxInt64, d))) // nolint:gosec
 		}
-		global.Warn("dropped log records", "dropped", d)
+		kv := []any{"dropped", d}
+		for i, n := range b.q.DroppedLevels() {
+			if n > 0 {
+				kv = append(kv, severityLevelNames[i], n)
+			}
+		}
+		global.Warn("dropped log records", kv...)
 	}
 }
 
@@ -301,6 +310,19 @@ func (b *BatchProcessor) Shutdown(ctx context.Context) error {
 	}
 }
 
+// DroppedRecords returns the number of log records dropped because the queue
+// was full, with a severity of the same severity level as severity. The
+// severity levels are undefined, trace, debug, info, warn, error, and fatal.
+// For example, the number of dropped log records with a severity from
+// [log.SeverityInfo1] to [log.SeverityInfo4] is returned for
+// [log.SeverityInfo].
+func (b *BatchProcessor) DroppedRecords(severity log.Severity) uint64 {
+	if b.q == nil {
+		return 0
+	}
+	return b.q.DroppedTotal(severityLevel(severity))
+}
+
 // ForceFlush flushes queued log records and flushes the decorated exporter.
 func (b *BatchProcessor) ForceFlush(ctx context.Context) error {
 	if b.stopped.Load() || b.q == nil {
@@ -331,7 +353,8 @@ func (b *BatchProcessor) ForceFlush(ctx context.Context) error {
 // queue holds a queue of logging records.
 //
 // When the queue becomes full, the oldest records in the queue are
-// overwritten.
+// overwritten. If priority is set, the oldest records with the lowest
+// severity level are overwritten instead.
 type queue struct {
 	sync.Mutex
 
@@ -339,6 +362,35 @@ type queue struct {
 	cap, len    int
 	read, write *ring
 	closed      bool
+
+	// priority is whether the records with the lowest severity level are
+	// dropped first when the queue is full.
+	priority bool
+	// heads and tails are the elements holding the oldest and newest records
+	// by severity level. The elements of a level are linked by levelNext.
+	heads, tails [numSeverityLevels]*ring
+	// droppedLevels are the number of records dropped by severity level
+	// since the last time DroppedLevels was called.
+	droppedLevels [numSeverityLevels]atomic.Uint64
+	// totalDroppedLevels are the number of records dropped by severity
+	// level.
+	totalDroppedLevels [numSeverityLevels]atomic.Uint64
+}
+
+// numSeverityLevels is the number of severity levels: undefined, trace,
+// debug, info, warn, error, and fatal.
+const numSeverityLevels = 7
+
+// severityLevelNames are the names of the severity levels.
+var severityLevelNames = [numSeverityLevels]string{"UNDEFINED", "TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// severityLevel returns the severity level of s. Severities above the fatal
+// ones are considered fatal.
+func severityLevel(s log.Severity) int {
+	if s <= log.SeverityUndefined {
+		return 0
+	}
+	return min(int(s-1)/4+1, numSeverityLevels-1)
 }
 
 func newQueue(size int) *queue {
@@ -363,11 +415,23 @@ func (q *queue) Dropped() uint64 {
 	return q.dropped.Swap(0)
 }
 
+// DroppedLevels returns the number of Records dropped during enqueueing by
+// severity level since the last time DroppedLevels was called.
+func (q *queue) DroppedLevels() [numSeverityLevels]uint64 {
+	var out [numSeverityLevels]uint64
+	for i := range q.droppedLevels {
+		out[i] = q.droppedLevels[i].Swap(0)
+	}
+	return out
+}
+
 // Enqueue adds r to the queue. The queue size, including the addition of r, is
 // returned.
 //
 // If enqueueing r would exceed the capacity of q, the oldest Record held in q
-// will be dropped and r will be retained.
+// will be dropped and r will be retained. If q has priority, the oldest Record
+// with the lowest severity level is dropped instead, or r itself if its
+// severity level is lower than the ones of all the Records held.
 func (q *queue) Enqueue(r Record) (int, bool) {
 	q.Lock()
 	defer q.Unlock()
@@ -376,19 +440,112 @@ func (q *queue) Enqueue(r Record) (int, bool) {
 		return q.len, false
 	}
 
-	q.write.Value = r
-	q.write = q.write.Next()
+	level := severityLevel(r.Severity())
+	if q.len == q.cap {
+		// Overflow.
+		if !q.priority {
+			dropped := q.take()
+			q.drop(severityLevel(dropped.Severity()))
+		} else {
+			lowest := q.lowestLevel()
+			if level < lowest {
+				q.drop(level)
+				return q.len, false
+			}
+			q.remove(lowest)
+		}
+	}
 
+	e := q.write
+	e.Value = r
+	q.pushLevel(level, e)
+	q.write = e.Next()
 	q.len++
-	if q.len > q.cap {
-		// Overflow. Advance read to be the new "oldest".
-		q.len = q.cap
-		q.read = q.read.Next()
-		q.dropped.Add(1)
-	}
 	return q.len, true
 }
 
+// lowestLevel returns the lowest severity level of the records held. It needs
+// to be called with the lock of q held.
+func (q *queue) lowestLevel() int {
+	for i, e := range q.heads {
+		if e != nil {
+			return i
+		}
+	}
+	return 0
+}
+
+// pushLevel adds e as the newest element of the severity level. It needs to
+// be called with the lock of q held.
+func (q *queue) pushLevel(level int, e *ring) {
+	e.levelNext = nil
+	if q.tails[level] == nil {
+		q.heads[level] = e
+	} else {
+		q.tails[level].levelNext = e
+	}
+	q.tails[level] = e
+}
+
+// popLevel removes and returns the oldest element of the severity level. It
+// needs to be called with the lock of q held.
+func (q *queue) popLevel(level int) *ring {
+	e := q.heads[level]
+	q.heads[level] = e.levelNext
+	if q.heads[level] == nil {
+		q.tails[level] = nil
+	}
+	e.levelNext = nil
+	return e
+}
+
+// remove drops the oldest record of the severity level. Its element is moved
+// to the write position so that the records held stay contiguous and
+// ordered. It needs to be called with the lock of q held.
+func (q *queue) remove(level int) {
+	e := q.popLevel(level)
+	q.drop(level)
+	e.Value = Record{}
+	q.len--
+	if e == q.read {
+		q.read = e.Next()
+		return
+	}
+
+	// Unlink e, and link it back before the write position.
+	e.prev.next, e.next.prev = e.next, e.prev
+	w := q.write
+	e.prev, e.next = w.prev, w
+	w.prev.next = e
+	w.prev = e
+	q.write = e
+}
+
+// drop counts a dropped record of the severity level.
+func (q *queue) drop(level int) {
+	q.dropped.Add(1)
+	q.droppedLevels[level].Add(1)
+	q.totalDroppedLevels[level].Add(1)
+}
+
+// DroppedTotal returns the number of Records dropped during enqueueing with
+// the severity level.
+func (q *queue) DroppedTotal(level int) uint64 {
+	return q.totalDroppedLevels[level].Load()
+}
+
+// take returns the oldest record held, and removes it. It needs to be called
+// with the lock of q held.
+func (q *queue) take() Record {
+	e := q.read
+	r := e.Value
+	q.popLevel(severityLevel(r.Severity()))
+	e.Value = Record{}
+	q.read = e.Next()
+	q.len--
+	return r
+}
+
 // Dequeue removes up to len(buf) records from the queue and copies them into
 // buf. The number copied and the number remaining are returned.
 func (q *queue) Dequeue(buf []Record) (int, int) {
@@ -397,11 +554,8 @@ func (q *queue) Dequeue(buf []Record) (int, int) {
 
 	n := min(len(buf), q.len)
 	for i := range n {
-		buf[i] = q.read.Value // nolint:gosec // n is bounded by len(buf)
-		q.read.Value = Record{}
-		q.read = q.read.Next()
+		buf[i] = q.take() // nolint:gosec // n is bounded by len(buf)
 	}
-	q.len -= n
 	return n, q.len
 }
 
@@ -424,20 +578,18 @@ func (q *queue) Close() {
 func (q *queue) flush() []Record {
 	out := make([]Record, q.len)
 	for i := range out {
-		out[i] = q.read.Value
-		q.read.Value = Record{}
-		q.read = q.read.Next()
+		out[i] = q.take()
 	}
-	q.len = 0
-
 	return out
 }
 
 type batchConfig struct {
-	maxQSize        setting[int]
-	expInterval     setting[time.Duration]
-	expTimeout      setting[time.Duration]
-	expMaxBatchSize setting[int]
+	maxQSize         setting[int]
+	expInterval      setting[time.Duration]
+	expTimeout       setting[time.Duration]
+	expMaxBatchSize  setting[int]
+	expMaxBatchBytes int
+	priority         bool
 }
 
 func newBatchConfig(options []BatchProcessorOption) batchConfig {
@@ -548,6 +700,49 @@ func WithExportMaxBatchSize(size int) BatchProcessorOption {
 	})
 }
 
+// WithExportMaxBatchBytes sets the maximum estimated size, in bytes, of the
+// OTLP protobuf encoding of every export. A batch will be split into multiple
+// exports so that none exceed this size, in addition to the limit set with
+// [WithExportMaxBatchSize]. A log record larger than this size is exported
+// alone.
+//
+// The size of a log record is estimated from its fields, body, and
+// attributes, and includes its resource and instrumentation scope. The
+// estimate is an upper bound of the encoded size. Use a value less than or
+// equal to the maximum request size of the exporter and of the receiver, e.g.
+// the maximum message size of the OpenTelemetry Collector, so that exports are
+// not rejected for being too large.
+//
+// By default, or if the provided value is less than one, the size of exports
+// is not limited.
+func WithExportMaxBatchBytes(size int) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.expMaxBatchBytes = size
+		return cfg
+	})
+}
+
+// WithSeverityPriority sets whether the queue of the Batcher drops the log
+// records with the lowest severity first when it is full. The severity levels
+// are, from the lowest: undefined, trace, debug, info, warn, error, and fatal.
+// When a log record is emitted to a full queue, the oldest log record with the
+// lowest severity level held is dropped. If the severity level of the emitted
+// log record is lower than the ones of all the log records held, it is dropped
+// instead. That way, a flood of debug log records does not drop the error log
+// records.
+//
+// The number of dropped log records by severity level is reported in the
+// warning logged by the Batcher when log records are dropped, and returned by
+// [BatchProcessor.DroppedRecords].
+//
+// By default, the oldest log record is dropped regardless of its severity.
+func WithSeverityPriority(enabled bool) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.priority = enabled
+		return cfg
+	})
+}
+
 // WithExportBufferSize is retained for source compatibility and has no effect.
 // The processor no longer maintains a separately configurable export-request
 // buffer. [WithMaxQueueSize] bounds the pending-record queue.
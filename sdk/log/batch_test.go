@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	stdlog "log"
+	"math/rand/v2"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log/internal/counter"
@@ -85,12 +88,16 @@ func TestNewBatchConfig(t *testing.T) {
 				WithExportTimeout(time.Hour),
 				WithExportMaxBatchSize(2),
 				WithExportBufferSize(3),
+				WithSeverityPriority(true),
+				WithExportMaxBatchBytes(1024),
 			},
 			want: batchConfig{
-				maxQSize:        newSetting(10),
-				expInterval:     newSetting(time.Microsecond),
-				expTimeout:      newSetting(time.Hour),
-				expMaxBatchSize: newSetting(2),
+				maxQSize:         newSetting(10),
+				expInterval:      newSetting(time.Microsecond),
+				expTimeout:       newSetting(time.Hour),
+				expMaxBatchSize:  newSetting(2),
+				expMaxBatchBytes: 1024,
+				priority:         true,
 			},
 		},
 		{
@@ -247,6 +254,26 @@ func TestBatchProcessor(t *testing.T) {
 		_ = b.Shutdown(ctx)
 	})
 
+	t.Run("SeverityPriority", func(t *testing.T) {
+		b := NewBatchProcessor(nil, WithSeverityPriority(true))
+		t.Cleanup(func() { assert.NoError(t, b.Shutdown(ctx)) })
+		assert.True(t, b.q.priority)
+	})
+
+	t.Run("DroppedRecords", func(t *testing.T) {
+		b := NewBatchProcessor(nil, WithSeverityPriority(true))
+		t.Cleanup(func() { assert.NoError(t, b.Shutdown(ctx)) })
+		b.q.drop(severityLevel(log.SeverityInfo2))
+		b.q.drop(severityLevel(log.SeverityInfo4))
+		b.q.drop(severityLevel(log.SeverityError))
+		_ = b.q.DroppedLevels()
+
+		assert.Equal(t, uint64(2), b.DroppedRecords(log.SeverityInfo), "not reset by DroppedLevels")
+		assert.Equal(t, uint64(1), b.DroppedRecords(log.SeverityError1))
+		assert.Zero(t, b.DroppedRecords(log.SeverityDebug))
+		assert.Zero(t, new(BatchProcessor).DroppedRecords(log.SeverityInfo))
+	})
+
 	t.Run("Enabled", func(t *testing.T) {
 		e := &testExporter{}
 		b := NewBatchProcessor(e)
@@ -585,7 +612,7 @@ func TestBatchProcessor(t *testing.T) {
 
 		releaseExport()
 
-		wantMsg := `"level"=1 "msg"="dropped log records" "dropped"=1`
+		wantMsg := `"level"=1 "msg"="dropped log records" "dropped"=1 "UNDEFINED"=1`
 		assert.EventuallyWithT(t, func(c *assert.CollectT) {
 			assert.Contains(c, buf.String(), wantMsg)
 		}, 2*time.Second, time.Microsecond)
@@ -848,12 +875,110 @@ func TestQueue(t *testing.T) {
 		assert.Equal(t, uint64(2), q.Dropped(), "second")
 	})
 
+	t.Run("DroppedLevels", func(t *testing.T) {
+		q := newQueue(1)
+
+		var debug, err Record
+		debug.SetSeverity(log.SeverityDebug2)
+		err.SetSeverity(log.SeverityError)
+		_, _ = q.Enqueue(debug)
+		_, _ = q.Enqueue(err)
+		_, _ = q.Enqueue(debug)
+		assert.Equal(t, [numSeverityLevels]uint64{2: 1, 5: 1}, q.DroppedLevels())
+		assert.Equal(t, [numSeverityLevels]uint64{}, q.DroppedLevels(), "reset")
+	})
+
+	t.Run("Priority", func(t *testing.T) {
+		q := newQueue(3)
+		q.priority = true
+		enqueue := func(body string, s log.Severity) bool {
+			var r Record
+			r.SetBody(attribute.StringValue(body))
+			r.SetSeverity(s)
+			_, accepted := q.Enqueue(r)
+			return accepted
+		}
+		bodies := func() []string {
+			var out []string
+			for _, r := range q.Flush() {
+				out = append(out, r.Body().AsString())
+			}
+			return out
+		}
+
+		assert.True(t, enqueue("info", log.SeverityInfo))
+		assert.True(t, enqueue("error", log.SeverityError))
+		assert.True(t, enqueue("debug", log.SeverityDebug))
+		assert.True(t, enqueue("warn", log.SeverityWarn), "lowest is dropped")
+		assert.True(t, enqueue("info2", log.SeverityInfo2), "oldest of the lowest is dropped")
+		assert.False(t, enqueue("trace", log.SeverityTrace), "lower than all")
+		assert.True(t, enqueue("fatal", log.SeverityFatal))
+		assert.Equal(t, []string{"error", "warn", "fatal"}, bodies(), "order is kept")
+		assert.Equal(t, [numSeverityLevels]uint64{1: 1, 2: 1, 3: 2}, q.DroppedLevels())
+		assert.Equal(t, uint64(4), q.Dropped())
+
+		assert.True(t, enqueue("debug", log.SeverityDebug), "levels are reset by Flush")
+		for i := range numSeverityLevels {
+			if i == 2 {
+				require.NotNil(t, q.heads[i])
+				assert.Same(t, q.heads[i], q.tails[i])
+				continue
+			}
+			assert.Nil(t, q.heads[i], "level %d", i)
+		}
+	})
+
+	t.Run("PriorityModel", func(t *testing.T) {
+		// Compare with a slice holding the records in order.
+		const size = 16
+		q := newQueue(size)
+		q.priority = true
+		var want []int64
+		sevs := make(map[int64]log.Severity)
+		rnd := rand.New(rand.NewPCG(1, 2))
+		for i := range int64(10000) {
+			if rnd.IntN(8) == 0 {
+				buf := make([]Record, rnd.IntN(size))
+				n, _ := q.Dequeue(buf)
+				for _, r := range buf[:n] {
+					require.Equal(t, want[0], r.Body().AsInt64(), "dequeued")
+					want = want[1:]
+				}
+				continue
+			}
+
+			var r Record
+			s := log.Severity(rnd.IntN(int(log.SeverityFatal4)) + 1)
+			r.SetSeverity(s)
+			r.SetBody(attribute.Int64Value(i))
+			_, accepted := q.Enqueue(r)
+
+			if len(want) == size {
+				lowest := slices.MinFunc(want, func(a, b int64) int {
+					return severityLevel(sevs[a]) - severityLevel(sevs[b])
+				})
+				if severityLevel(s) < severityLevel(sevs[lowest]) {
+					require.False(t, accepted, "record %d", i)
+					continue
+				}
+				want = slices.DeleteFunc(want, func(v int64) bool { return v == lowest })
+			}
+			require.True(t, accepted, "record %d", i)
+			want = append(want, i)
+			sevs[i] = s
+		}
+
+		var got []int64
+		for _, r := range q.Flush() {
+			got = append(got, r.Body().AsInt64())
+		}
+		assert.Equal(t, want, got)
+	})
+
 	t.Run("Flush", func(t *testing.T) {
 		const size = 2
 		q := newQueue(size)
-		q.write.Value = r
-		q.write = q.write.Next()
-		q.len = 1
+		_, _ = q.Enqueue(r)
 
 		assert.Equal(t, []Record{r}, q.Flush(), "flushed")
 	})
@@ -862,9 +987,7 @@ func TestQueue(t *testing.T) {
 		const size = 3
 		q := newQueue(size)
 		for range size - 1 {
-			q.write.Value = r
-			q.write = q.write.Next()
-			q.len++
+			_, _ = q.Enqueue(r)
 		}
 
 		buf := make([]Record, 1)
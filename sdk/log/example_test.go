@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/log"
 )
 
@@ -52,7 +56,121 @@ func Example() {
 	// slog.SetDefault(otelslog.NewLogger("my/pkg/name", otelslog.WithLoggerProvider(provider)))
 }
 
+// Use a FilterProcessor to only process log records with a minimum severity.
+func ExampleFilterProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Wrap the processor so that it only processes log records with a
+	// severity of at least INFO, except for the "noisy/pkg" scope whose
+	// log records need a severity of at least WARN.
+	filter := log.NewFilterProcessor(
+		processor,
+		log.WithMinSeverity(otellog.SeverityInfo),
+		log.WithScopeMinSeverity("noisy/pkg", otellog.SeverityWarn),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(filter),
+	)
+
+	// The minimum severities can be changed at runtime, e.g. to debug an
+	// issue. Bridges using Logger.Enabled skip the filtered log records.
+	filter.SetMinSeverity(otellog.SeverityDebug)
+}
+
+// Use a TraceSamplingProcessor so that the log volume follows the sampling
+// decisions of traces.
+func ExampleTraceSamplingProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Wrap the processor so that it only processes the log records emitted
+	// in sampled traces, and the ones with a severity of at least ERROR.
+	sampler := log.NewTraceSamplingProcessor(
+		processor,
+		log.WithSeverityFloor(otellog.SeverityError),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(sampler),
+	)
+}
+
+// Use a RateLimitProcessor so that a hot loop emitting the same log record
+// does not evict other log records from the queue of a BatchProcessor.
+func ExampleRateLimitProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Wrap the processor so that it processes at most 5 identical log
+	// records per second, after a burst of 50. The suppressed log records are
+	// summarized every 30 seconds.
+	limiter := log.NewRateLimitProcessor(
+		processor,
+		log.WithRateLimit(5, 50),
+		log.WithSummaryInterval(30*time.Second),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option. Shutting down
+	// the provider stops the processor.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(limiter),
+	)
+}
+
 // Use a processor that filters out records based on the provided context.
+func ExampleMetricsProcessor() {
+	// Existing MeterProvider that exports metrics.
+	var meterProvider metric.MeterProvider = noop.NewMeterProvider()
+
+	// Count the log records by scope, severity, and their "http.route"
+	// attribute, and record their "duration" attribute in a histogram.
+	metrics := log.NewMetricsProcessor(
+		meterProvider,
+		log.WithMetricAttributeFilter(attribute.NewAllowKeysFilter("http.route")),
+		log.WithValueHistogram("log.record.duration", "s", "duration"),
+	)
+
+	// The processor is registered in addition to the processor that exports
+	// the log records.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(metrics),
+		log.WithProcessor(log.NewBatchProcessor(nil)),
+	)
+}
+
+func ExampleRoutingProcessor() {
+	// Existing processors that export to different backends.
+	var (
+		audit log.Processor = log.NewBatchProcessor(nil)
+		app   log.Processor = log.NewBatchProcessor(nil)
+	)
+
+	// Send the log records of the audit scope, and the audit events of all
+	// scopes, to the audit backend. Send the other log records to the
+	// application backend.
+	router := log.NewRoutingProcessor(
+		log.WithRoute(
+			log.MatchAny(log.MatchScope("example.com/audit"), log.MatchEventName("audit")),
+			audit,
+		),
+		log.WithDefaultRoute(app),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option. Shutting down
+	// the provider shuts down the processors of all routes.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(router),
+	)
+}
+
 func ExampleProcessor_contextFilter() {
 	// Existing processor that emits telemetry.
 	var processor log.Processor = log.NewBatchProcessor(nil)
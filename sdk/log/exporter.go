@@ -6,9 +6,12 @@ package log
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log/internal/observ"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // ErrExporterShutdown is returned if Export is called after an
@@ -83,29 +86,34 @@ type chunkExporter struct {
 
 	// size is the maximum batch size exported.
 	size int
+	// bytes is the maximum estimated encoded size of a batch exported. It is
+	// not limited if it is less than or equal to 0.
+	bytes int
 }
 
 // newChunkExporter wraps exporter. Record payloads passed to Export are
-// chunked so that they do not exceed size. If size is less than or equal to 0,
-// exporter is returned directly.
-func newChunkExporter(exporter Exporter, size int) Exporter {
-	if size <= 0 {
+// chunked so that they do not exceed size records, and bytes estimated
+// encoded bytes. If both size and bytes are less than or equal to 0, exporter
+// is returned directly.
+func newChunkExporter(exporter Exporter, size, bytes int) Exporter {
+	if size <= 0 && bytes <= 0 {
 		return exporter
 	}
-	return &chunkExporter{Exporter: exporter, size: size}
+	return &chunkExporter{Exporter: exporter, size: size, bytes: bytes}
 }
 
-// Export exports records in chunks no larger than c.size.
+// Export exports records in chunks no larger than c.size and c.bytes.
 func (c chunkExporter) Export(ctx context.Context, records []Record) error {
-	n := len(records)
 	var errs []error
-	for i, j := 0, min(c.size, n); i < n; i, j = i+c.size, min(j+c.size, n) {
+	for len(records) > 0 {
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return errors.Join(append(errs, ctxErr)...)
 		}
-		if err := c.Exporter.Export(ctx, records[i:j]); err != nil {
+		n := c.chunkLen(records)
+		if err := c.Exporter.Export(ctx, records[:n]); err != nil {
 			errs = append(errs, err)
 		}
+		records = records[n:]
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return errors.Join(append(errs, ctxErr)...)
 		}
@@ -113,6 +121,42 @@ func (c chunkExporter) Export(ctx context.Context, records []Record) error {
 	return errors.Join(errs...)
 }
 
+// chunkLen returns the number of records in the first chunk of records. A
+// record larger than c.bytes on its own is exported in a chunk of its own.
+func (c chunkExporter) chunkLen(records []Record) int {
+	n := len(records)
+	if c.size > 0 {
+		n = min(n, c.size)
+	}
+	if c.bytes <= 0 {
+		return n
+	}
+
+	var (
+		total     int
+		resources []*resource.Resource
+		scopes    []*instrumentation.Scope
+	)
+	for i := range n {
+		r := &records[i]
+		size := recordSize(r)
+		// The resource and scope are encoded once per export request.
+		if !slices.Contains(resources, r.resource) {
+			resources = append(resources, r.resource)
+			size += resourceSize(r.resource)
+		}
+		if !slices.Contains(scopes, r.scope) {
+			scopes = append(scopes, r.scope)
+			size += scopeSize(r.scope)
+		}
+		total += size
+		if total > c.bytes && i > 0 {
+			return i
+		}
+	}
+	return n
+}
+
 // timeoutExporter wraps an Exporter and adds a timeout to the context of any
 // call to Export.
 type timeoutExporter struct {
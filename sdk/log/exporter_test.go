@@ -6,6 +6,7 @@ package log
 import (
 	"context"
 	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -13,6 +14,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 type testExporter struct {
@@ -116,7 +121,7 @@ func (e *testExporter) Calls() []string {
 func TestChunker(t *testing.T) {
 	t.Run("ZeroSize", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		const size = 100
 		_ = c.Export(t.Context(), make([]Record, size))
 
@@ -128,21 +133,21 @@ func TestChunker(t *testing.T) {
 
 	t.Run("ForceFlush", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		_ = c.ForceFlush(t.Context())
 		assert.Equal(t, 1, exp.ForceFlushN(), "ForceFlush not passed through")
 	})
 
 	t.Run("Shutdown", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		_ = c.Shutdown(t.Context())
 		assert.Equal(t, 1, exp.ShutdownN(), "Shutdown not passed through")
 	})
 
 	t.Run("Chunk", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 10)
+		c := newChunkExporter(exp, 10, 0)
 		assert.NoError(t, c.Export(t.Context(), make([]Record, 5)))
 		assert.NoError(t, c.Export(t.Context(), make([]Record, 25)))
 
@@ -154,15 +159,49 @@ func TestChunker(t *testing.T) {
 		}
 	})
 
+	t.Run("Bytes", func(t *testing.T) {
+		scope := &instrumentation.Scope{Name: "scope"}
+		res := resource.NewSchemaless(attribute.String("service.name", "svc"))
+		newRecords := func(sizes ...int) []Record {
+			records := make([]Record, len(sizes))
+			for i, n := range sizes {
+				records[i] = Record{scope: scope, resource: res}
+				records[i].SetBody(attribute.StringValue(strings.Repeat("x", n)))
+			}
+			return records
+		}
+		records := newRecords(1000, 1000, 1000, 1000, 1000)
+		overhead := resourceSize(records[0].resource) + scopeSize(records[0].scope)
+		// Room for two records per export.
+		limit := overhead + 2*recordSize(&records[0]) + 10
+
+		exp := &testExporter{}
+		c := newChunkExporter(exp, 0, limit)
+		assert.NoError(t, c.Export(t.Context(), records))
+		assert.NoError(t, c.Export(t.Context(), newRecords(10, 5000, 10)))
+
+		c = newChunkExporter(exp, 1, limit)
+		assert.NoError(t, c.Export(t.Context(), newRecords(10, 10)))
+
+		got := exp.Records()
+		lens := make([]int, len(got))
+		for i, records := range got {
+			lens[i] = len(records)
+		}
+		assert.Equal(t, []int{2, 2, 1, 1, 1, 1, 1, 1}, lens, "chunks")
+		require.Len(t, got, 8)
+		assert.Len(t, got[4][0].Body().AsString(), 5000, "record larger than limit exported alone")
+	})
+
 	t.Run("ExportError", func(t *testing.T) {
 		exp := &testExporter{Err: assert.AnError}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		ctx := t.Context()
 		records := make([]Record, 25)
 		err := c.Export(ctx, records)
 		assert.ErrorIs(t, err, assert.AnError, "no chunking")
 
-		c = newChunkExporter(exp, 10)
+		c = newChunkExporter(exp, 10, 0)
 		err = c.Export(ctx, records)
 		assert.ErrorIs(t, err, assert.AnError, "with chunking")
 		assert.Equal(t, 4, exp.ExportN(), "all chunks attempted")
@@ -173,7 +212,7 @@ func TestChunker(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel()
 
-		err := newChunkExporter(exp, 10).Export(ctx, make([]Record, 25))
+		err := newChunkExporter(exp, 10, 0).Export(ctx, make([]Record, 25))
 		assert.ErrorIs(t, err, context.Canceled)
 		assert.Zero(t, exp.ExportN(), "Export calls")
 	})
@@ -187,7 +226,7 @@ func TestChunker(t *testing.T) {
 			return assert.AnError
 		}
 
-		c := newChunkExporter(exp, 10)
+		c := newChunkExporter(exp, 10, 0)
 		err := c.Export(ctx, make([]Record, 25))
 		assert.ErrorIs(t, err, assert.AnError)
 		assert.ErrorIs(t, err, context.Canceled)
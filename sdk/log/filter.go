@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+)
+
+const envarMinSeverity = "OTEL_GO_X_LOG_MIN_SEVERITY"
+
+// This is a compile-time check that FilterProcessor implements Processor.
+var _ Processor = (*FilterProcessor)(nil)
+
+// FilterProcessor is a Processor that only passes log records with a minimum
+// severity to the Processor it wraps.
+//
+// The minimum severity can be overridden for the log records of an
+// instrumentation scope or of an event name. The minimum severity of an event
+// name has precedence over the one of a scope, which has precedence over the
+// default minimum severity.
+//
+// Log records with an undefined severity are always passed. Their severity is
+// unknown, so they cannot be compared to a minimum.
+//
+// The minimum severities can be changed while the FilterProcessor is in use.
+// Its Enabled method reports the filtering, so that bridges using
+// [log.Logger.Enabled] can skip building log records that would be dropped.
+//
+// Use [NewFilterProcessor] to create a FilterProcessor.
+type FilterProcessor struct {
+	processor Processor
+
+	// mu serializes the changes of levels.
+	mu     sync.Mutex
+	levels atomic.Pointer[severityLevels]
+}
+
+// severityLevels are the minimum severities of a FilterProcessor. They are
+// never modified once stored, changes are made on copies.
+type severityLevels struct {
+	min    log.Severity
+	scopes map[string]log.Severity
+	events map[string]log.Severity
+}
+
+// get returns the minimum severity of a log record with the instrumentation
+// scope named scope and the event named event.
+func (l *severityLevels) get(scope, event string) log.Severity {
+	if event != "" {
+		if s, ok := l.events[event]; ok {
+			return s
+		}
+	}
+	if s, ok := l.scopes[scope]; ok {
+		return s
+	}
+	return l.min
+}
+
+func (l *severityLevels) clone() *severityLevels {
+	return &severityLevels{
+		min:    l.min,
+		scopes: maps.Clone(l.scopes),
+		events: maps.Clone(l.events),
+	}
+}
+
+// NewFilterProcessor returns a new FilterProcessor that passes the log records
+// with a minimum severity to processor.
+//
+// If the experimental OTEL_GO_X_LOG_MIN_SEVERITY environment variable is set,
+// the minimum severities it holds are used, unless overridden by opts. It is
+// not part of the OpenTelemetry specification and may change or be removed in a
+// future release. It is a comma-separated list of severities, e.g.
+// "WARN,my/pkg=DEBUG". An entry without a name sets the default minimum
+// severity, and an entry prefixed by a scope name and an equal sign sets the
+// minimum severity of that scope. Severities are either names, e.g. "info" or
+// "ERROR2", or numbers.
+//
+// By default, all log records are passed.
+func NewFilterProcessor(processor Processor, opts ...FilterProcessorOption) *FilterProcessor {
+	levels := &severityLevels{
+		scopes: make(map[string]log.Severity),
+		events: make(map[string]log.Severity),
+	}
+	if v := os.Getenv(envarMinSeverity); v != "" {
+		if err := levels.parse(v); err != nil {
+			otel.Handle(fmt.Errorf("invalid %s value %s: %w", envarMinSeverity, v, err))
+		}
+	}
+	for _, opt := range opts {
+		opt.apply(levels)
+	}
+
+	f := &FilterProcessor{processor: processor}
+	f.levels.Store(levels)
+	return f
+}
+
+// parse sets the minimum severities of the comma-separated list s. The valid
+// entries are set even if some are invalid.
+func (l *severityLevels) parse(s string) error {
+	var invalid []string
+	for entry := range strings.SplitSeq(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		scope, sev, hasScope := strings.Cut(entry, "=")
+		if !hasScope {
+			sev = scope
+		}
+		severity, ok := parseSeverity(strings.TrimSpace(sev))
+		if !ok {
+			invalid = append(invalid, entry)
+			continue
+		}
+		if hasScope {
+			l.scopes[strings.TrimSpace(scope)] = severity
+		} else {
+			l.min = severity
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid severities: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// parseSeverity returns the severity named s, case-insensitively, or numbered
+// s.
+func parseSeverity(s string) (log.Severity, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		sev := log.Severity(n)
+		return sev, sev >= log.SeverityUndefined && sev <= log.SeverityFatal4
+	}
+	for sev := log.SeverityUndefined; sev <= log.SeverityFatal4; sev++ {
+		if strings.EqualFold(s, sev.String()) {
+			return sev, true
+		}
+	}
+	return 0, false
+}
+
+// SetMinSeverity sets the default minimum severity of the log records passed
+// by f.
+func (f *FilterProcessor) SetMinSeverity(minimum log.Severity) {
+	f.update(func(l *severityLevels) { l.min = minimum })
+}
+
+// SetScopeMinSeverity sets the minimum severity of the log records passed by
+// f that are emitted with the instrumentation scope named scope.
+func (f *FilterProcessor) SetScopeMinSeverity(scope string, minimum log.Severity) {
+	f.update(func(l *severityLevels) { l.scopes[scope] = minimum })
+}
+
+// SetEventMinSeverity sets the minimum severity of the log records passed by
+// f that have the event name event.
+func (f *FilterProcessor) SetEventMinSeverity(event string, minimum log.Severity) {
+	f.update(func(l *severityLevels) { l.events[event] = minimum })
+}
+
+func (f *FilterProcessor) update(fn func(*severityLevels)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	levels := f.levels.Load().clone()
+	fn(levels)
+	f.levels.Store(levels)
+}
+
+// pass reports whether a log record with severity, the instrumentation scope
+// named scope, and the event named event is passed by f.
+func (f *FilterProcessor) pass(severity log.Severity, scope, event string) bool {
+	if severity == log.SeverityUndefined {
+		return true
+	}
+	return severity >= f.levels.Load().get(scope, event)
+}
+
+// Enabled returns false if a log record with param is filtered out by f.
+// Otherwise, it returns the result of Enabled of the wrapped Processor.
+func (f *FilterProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if !f.pass(param.Severity, param.InstrumentationScope.Name, param.EventName) {
+		return false
+	}
+	return f.processor.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the wrapped Processor if it is not filtered out by
+// f.
+func (f *FilterProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if !f.pass(record.Severity(), record.InstrumentationScope().Name, record.EventName()) {
+		return nil
+	}
+	return f.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped Processor.
+func (f *FilterProcessor) Shutdown(ctx context.Context) error {
+	return f.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped Processor.
+func (f *FilterProcessor) ForceFlush(ctx context.Context) error {
+	return f.processor.ForceFlush(ctx)
+}
+
+// FilterProcessorOption applies a configuration to a [FilterProcessor].
+type FilterProcessorOption interface {
+	apply(*severityLevels)
+}
+
+type filterOptionFunc func(*severityLevels)
+
+func (fn filterOptionFunc) apply(l *severityLevels) {
+	fn(l)
+}
+
+// WithMinSeverity sets the default minimum severity of the log records passed
+// by a [FilterProcessor].
+//
+// If this option is not passed, the default minimum severity of the
+// OTEL_GO_X_LOG_MIN_SEVERITY environment variable is used, if set. Otherwise,
+// all log records are passed.
+//
+// Use [FilterProcessor.SetMinSeverity] to change it afterwards.
+func WithMinSeverity(minimum log.Severity) FilterProcessorOption {
+	return filterOptionFunc(func(l *severityLevels) { l.min = minimum })
+}
+
+// WithScopeMinSeverity sets the minimum severity of the log records passed by
+// a [FilterProcessor] that are emitted with the instrumentation scope named
+// scope.
+//
+// It overrides the minimum severity set for the same scope by the
+// OTEL_GO_X_LOG_MIN_SEVERITY environment variable.
+//
+// Use [FilterProcessor.SetScopeMinSeverity] to change it afterwards.
+func WithScopeMinSeverity(scope string, minimum log.Severity) FilterProcessorOption {
+	return filterOptionFunc(func(l *severityLevels) { l.scopes[scope] = minimum })
+}
+
+// WithEventMinSeverity sets the minimum severity of the log records passed by
+// a [FilterProcessor] that have the event name event.
+//
+// Use [FilterProcessor.SetEventMinSeverity] to change it afterwards.
+func WithEventMinSeverity(event string, minimum log.Severity) FilterProcessorOption {
+	return filterOptionFunc(func(l *severityLevels) { l.events[event] = minimum })
+}
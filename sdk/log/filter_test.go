@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func filterRecord(scope, event string, severity log.Severity) *Record {
+	r := &Record{scope: &instrumentation.Scope{Name: scope}}
+	r.SetEventName(event)
+	r.SetSeverity(severity)
+	return r
+}
+
+func TestFilterProcessor(t *testing.T) {
+	f := NewFilterProcessor(
+		newProcessor("wrapped"),
+		WithMinSeverity(log.SeverityInfo),
+		WithScopeMinSeverity("noisy", log.SeverityError),
+		WithScopeMinSeverity("debugged", log.SeverityDebug),
+		WithEventMinSeverity("audit", log.SeverityTrace),
+	)
+
+	tests := []struct {
+		name     string
+		scope    string
+		event    string
+		severity log.Severity
+		want     bool
+	}{
+		{name: "BelowMin", severity: log.SeverityDebug4},
+		{name: "AtMin", severity: log.SeverityInfo, want: true},
+		{name: "AboveMin", severity: log.SeverityFatal, want: true},
+		{name: "Undefined", severity: log.SeverityUndefined, want: true},
+		{name: "ScopeBelowMin", scope: "noisy", severity: log.SeverityWarn4},
+		{name: "ScopeAtMin", scope: "noisy", severity: log.SeverityError, want: true},
+		{name: "ScopeLowered", scope: "debugged", severity: log.SeverityDebug, want: true},
+		{name: "OtherScope", scope: "noisy/child", severity: log.SeverityInfo, want: true},
+		{name: "Event", scope: "noisy", event: "audit", severity: log.SeverityTrace, want: true},
+		{name: "OtherEvent", scope: "noisy", event: "other", severity: log.SeverityInfo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param := EnabledParameters{
+				InstrumentationScope: instrumentation.Scope{Name: tt.scope},
+				Severity:             tt.severity,
+				EventName:            tt.event,
+			}
+			assert.Equal(t, tt.want, f.Enabled(t.Context(), param), "Enabled")
+
+			p := newProcessor("wrapped")
+			f.processor = p
+			require.NoError(t, f.OnEmit(t.Context(), filterRecord(tt.scope, tt.event, tt.severity)))
+			assert.Equal(t, tt.want, len(p.records) == 1, "OnEmit")
+		})
+	}
+}
+
+func TestFilterProcessorWrapped(t *testing.T) {
+	p := newProcessor("wrapped")
+	p.Err = errors.New("processor error")
+	p.enabledFunc = func(context.Context, EnabledParameters) bool { return false }
+	f := NewFilterProcessor(p)
+
+	assert.False(t, f.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityInfo}))
+	assert.ErrorIs(t, f.OnEmit(t.Context(), filterRecord("", "", log.SeverityInfo)), p.Err)
+	assert.ErrorIs(t, f.ForceFlush(t.Context()), p.Err)
+	assert.ErrorIs(t, f.Shutdown(t.Context()), p.Err)
+	assert.Equal(t, 1, p.forceFlushCalls)
+	assert.Equal(t, 1, p.shutdownCalls)
+}
+
+func TestFilterProcessorSet(t *testing.T) {
+	f := NewFilterProcessor(newProcessor("wrapped"), WithMinSeverity(log.SeverityWarn))
+	enabled := func(scope, event string, severity log.Severity) bool {
+		return f.Enabled(t.Context(), EnabledParameters{
+			InstrumentationScope: instrumentation.Scope{Name: scope},
+			Severity:             severity,
+			EventName:            event,
+		})
+	}
+
+	assert.False(t, enabled("", "", log.SeverityInfo))
+	f.SetMinSeverity(log.SeverityInfo)
+	assert.True(t, enabled("", "", log.SeverityInfo))
+
+	f.SetScopeMinSeverity("scope", log.SeverityError)
+	assert.False(t, enabled("scope", "", log.SeverityWarn))
+	assert.True(t, enabled("other", "", log.SeverityWarn))
+
+	f.SetEventMinSeverity("event", log.SeverityDebug)
+	assert.True(t, enabled("scope", "event", log.SeverityDebug))
+	assert.False(t, enabled("scope", "", log.SeverityDebug))
+}
+
+func TestFilterProcessorEnvironment(t *testing.T) {
+	t.Setenv(envarMinSeverity, " warn , noisy=ERROR2,debugged = 5")
+	f := NewFilterProcessor(newProcessor("wrapped"), WithScopeMinSeverity("debugged", log.SeverityTrace))
+
+	levels := f.levels.Load()
+	assert.Equal(t, log.SeverityWarn, levels.min)
+	assert.Equal(t, map[string]log.Severity{
+		"noisy":    log.SeverityError2,
+		"debugged": log.SeverityTrace,
+	}, levels.scopes)
+
+	f = NewFilterProcessor(newProcessor("wrapped"), WithMinSeverity(log.SeverityInfo))
+	assert.Equal(t, log.SeverityInfo, f.levels.Load().min)
+}
+
+func TestFilterProcessorInvalidEnvironment(t *testing.T) {
+	original := otel.GetErrorHandler()
+	var handled []error
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+	t.Cleanup(func() { otel.SetErrorHandler(original) })
+
+	t.Setenv(envarMinSeverity, "loud,scope=ERROR,other=25")
+	f := NewFilterProcessor(newProcessor("wrapped"))
+
+	require.Len(t, handled, 1)
+	assert.ErrorContains(t, handled[0], "invalid severities: loud, other=25")
+	levels := f.levels.Load()
+	assert.Equal(t, log.SeverityUndefined, levels.min)
+	assert.Equal(t, map[string]log.Severity{"scope": log.SeverityError}, levels.scopes)
+}
+
+func TestParseSeverity(t *testing.T) {
+	for s, want := range map[string]log.Severity{
+		"TRACE":     log.SeverityTrace1,
+		"debug3":    log.SeverityDebug3,
+		"Info":      log.SeverityInfo,
+		"FATAL4":    log.SeverityFatal4,
+		"UNDEFINED": log.SeverityUndefined,
+		"17":        log.SeverityError,
+	} {
+		got, ok := parseSeverity(s)
+		assert.True(t, ok, s)
+		assert.Equal(t, want, got, s)
+	}
+	for _, s := range []string{"", "INFO5", "-1", "25", "warning"} {
+		_, ok := parseSeverity(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestFilterProcessorConcurrentSafe(*testing.T) {
+	const goRoutineN = 10
+
+	var wg sync.WaitGroup
+	wg.Add(goRoutineN)
+
+	ctx := context.Background()
+	f := NewFilterProcessor(NewSimpleProcessor(nil))
+	for i := range goRoutineN {
+		go func() {
+			defer wg.Done()
+
+			r := filterRecord("scope", "event", log.Severity(i))
+			_ = f.Enabled(ctx, EnabledParameters{Severity: log.Severity(i)})
+			_ = f.OnEmit(ctx, r)
+			f.SetMinSeverity(log.Severity(i))
+			f.SetScopeMinSeverity("scope", log.Severity(i))
+			f.SetEventMinSeverity("event", log.Severity(i))
+		}()
+	}
+
+	wg.Wait()
+}
@@ -145,9 +145,14 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		scope:                     &l.instrumentationScope,
 		attributeValueLengthLimit: l.provider.attributeValueLengthLimit,
 		attributeCountLimit:       l.provider.attributeCountLimit,
+		bodyLengthLimit:           l.provider.bodyLengthLimit,
+		valueDepthLimit:           l.provider.valueDepthLimit,
+		truncatedAttr:             l.provider.truncatedAttr,
+		deferTruncated:            l.provider.truncatedAttr,
 		allowDupKeys:              l.provider.allowDupKeys,
 	}
-	// This ensures we deduplicate key-value collections in the log body
+	// This ensures we deduplicate key-value collections in the log body and
+	// apply the body limits.
 	newRecord.SetBody(r.Body())
 
 	// This field SHOULD be set once the event is observed by OpenTelemetry.
@@ -219,6 +224,9 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		}
 	}
 
+	// Add the TruncatedKey attribute after all the attributes of r.
+	newRecord.flushTruncated()
+
 	return newRecord
 }
 
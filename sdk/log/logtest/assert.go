@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// TestingT reports failure messages.
+// *testing.T implements this interface.
+type TestingT interface {
+	Errorf(format string, args ...any)
+}
+
+// AssertRecordEqual asserts that the log records want and got are equal.
+//
+// Attributes are compared regardless of their order. Use opts to ignore
+// properties of the log records, e.g. timestamps that vary between runs.
+//
+// If the assertion fails, the differences are reported to t and false is
+// returned.
+func AssertRecordEqual(t TestingT, want, got sdklog.Record, opts ...AssertOption) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	diffs := newAssertConfig(opts).diff(want, got)
+	if len(diffs) == 0 {
+		return true
+	}
+	t.Errorf("log records are not equal:\n\t%s", strings.Join(diffs, "\n\t"))
+	return false
+}
+
+// AssertRecordsEqual asserts that the log records want and got are equal and
+// in the same order.
+//
+// Attributes are compared regardless of their order. Use opts to ignore
+// properties of the log records, e.g. timestamps that vary between runs.
+//
+// If the assertion fails, the differences are reported to t and false is
+// returned.
+func AssertRecordsEqual(t TestingT, want, got []sdklog.Record, opts ...AssertOption) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	cfg := newAssertConfig(opts)
+	var diffs []string
+	if len(want) != len(got) {
+		diffs = append(diffs, fmt.Sprintf("expected %d log records, got %d", len(want), len(got)))
+	}
+	for i := range min(len(want), len(got)) {
+		for _, d := range cfg.diff(want[i], got[i]) {
+			diffs = append(diffs, fmt.Sprintf("record %d: %s", i, d))
+		}
+	}
+	if len(diffs) == 0 {
+		return true
+	}
+	t.Errorf("log records are not equal:\n\t%s", strings.Join(diffs, "\n\t"))
+	return false
+}
+
+type assertConfig struct {
+	ignoreTimestamp         bool
+	ignoreObservedTimestamp bool
+}
+
+func newAssertConfig(opts []AssertOption) assertConfig {
+	var cfg assertConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// AssertOption allows for fine-grained control over how AssertRecordEqual and
+// AssertRecordsEqual compare log records.
+type AssertOption interface {
+	apply(cfg assertConfig) assertConfig
+}
+
+type fnOption func(cfg assertConfig) assertConfig
+
+func (fn fnOption) apply(cfg assertConfig) assertConfig {
+	return fn(cfg)
+}
+
+// IgnoreTimestamp returns an AssertOption that makes the comparison ignore
+// the timestamps of log records.
+func IgnoreTimestamp() AssertOption {
+	return fnOption(func(cfg assertConfig) assertConfig {
+		cfg.ignoreTimestamp = true
+		return cfg
+	})
+}
+
+// IgnoreObservedTimestamp returns an AssertOption that makes the comparison
+// ignore the observed timestamps of log records.
+func IgnoreObservedTimestamp() AssertOption {
+	return fnOption(func(cfg assertConfig) assertConfig {
+		cfg.ignoreObservedTimestamp = true
+		return cfg
+	})
+}
+
+// diff returns the differences of got from want.
+func (cfg assertConfig) diff(want, got sdklog.Record) []string {
+	var diffs []string
+	field := func(name string, equal bool, want, got any) {
+		if !equal {
+			diffs = append(diffs, fmt.Sprintf("%s: expected %v, got %v", name, want, got))
+		}
+	}
+
+	field("event name", want.EventName() == got.EventName(), fmt.Sprintf("%q", want.EventName()),
+		fmt.Sprintf("%q", got.EventName()))
+	if !cfg.ignoreTimestamp {
+		field("timestamp", want.Timestamp().Equal(got.Timestamp()), want.Timestamp(), got.Timestamp())
+	}
+	if !cfg.ignoreObservedTimestamp {
+		field("observed timestamp", want.ObservedTimestamp().Equal(got.ObservedTimestamp()),
+			want.ObservedTimestamp(), got.ObservedTimestamp())
+	}
+	field("severity", want.Severity() == got.Severity(), want.Severity(), got.Severity())
+	field("severity text", want.SeverityText() == got.SeverityText(), fmt.Sprintf("%q", want.SeverityText()),
+		fmt.Sprintf("%q", got.SeverityText()))
+	field("body", reflect.DeepEqual(want.Body(), got.Body()), formatValue(want.Body()), formatValue(got.Body()))
+	diffs = append(diffs, diffAttributes(attributes(want), attributes(got))...)
+	field("dropped attributes", want.DroppedAttributes() == got.DroppedAttributes(),
+		want.DroppedAttributes(), got.DroppedAttributes())
+	field("trace ID", want.TraceID() == got.TraceID(), want.TraceID(), got.TraceID())
+	field("span ID", want.SpanID() == got.SpanID(), want.SpanID(), got.SpanID())
+	field("trace flags", want.TraceFlags() == got.TraceFlags(), want.TraceFlags(), got.TraceFlags())
+	field("resource", want.Resource().Equal(got.Resource()), formatResource(want.Resource()),
+		formatResource(got.Resource()))
+	field("instrumentation scope", equalScope(want.InstrumentationScope(), got.InstrumentationScope()),
+		formatScope(want.InstrumentationScope()), formatScope(got.InstrumentationScope()))
+	return diffs
+}
+
+func attributes(r sdklog.Record) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, r.AttributesLen())
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+	slices.SortStableFunc(attrs, func(a, b attribute.KeyValue) int {
+		return strings.Compare(string(a.Key), string(b.Key))
+	})
+	return attrs
+}
+
+// diffAttributes returns the differences of the attributes got from want.
+// Both need to be sorted by key.
+func diffAttributes(want, got []attribute.KeyValue) []string {
+	var diffs []string
+	i, j := 0, 0
+	for i < len(want) || j < len(got) {
+		switch {
+		case j == len(got) || (i < len(want) && want[i].Key < got[j].Key):
+			diffs = append(diffs, fmt.Sprintf("missing attribute %s=%s", want[i].Key, formatValue(want[i].Value)))
+			i++
+		case i == len(want) || got[j].Key < want[i].Key:
+			diffs = append(diffs, fmt.Sprintf("unexpected attribute %s=%s", got[j].Key, formatValue(got[j].Value)))
+			j++
+		default:
+			if !reflect.DeepEqual(want[i].Value, got[j].Value) {
+				diffs = append(diffs, fmt.Sprintf(
+					"attribute %s: expected %s, got %s",
+					want[i].Key,
+					formatValue(want[i].Value),
+					formatValue(got[j].Value),
+				))
+			}
+			i++
+			j++
+		}
+	}
+	return diffs
+}
+
+func formatValue(v attribute.Value) string {
+	if v.Type() == attribute.STRING {
+		return fmt.Sprintf("%q", v.AsString())
+	}
+	return v.String()
+}
+
+func formatResource(r *resource.Resource) string {
+	if r == nil {
+		return "<nil>"
+	}
+	return "{" + r.String() + "}"
+}
+
+func equalScope(a, b instrumentation.Scope) bool {
+	return a.Name == b.Name && a.Version == b.Version && a.SchemaURL == b.SchemaURL &&
+		a.Attributes.Equals(&b.Attributes)
+}
+
+func formatScope(s instrumentation.Scope) string {
+	return fmt.Sprintf(
+		"{name=%q version=%q schemaURL=%q attributes={%s}}",
+		s.Name,
+		s.Version,
+		s.SchemaURL,
+		s.Attributes.Encoded(attribute.DefaultEncoder()),
+	)
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mockTestingT struct {
+	errors []string
+}
+
+func (m *mockTestingT) Errorf(format string, args ...any) {
+	m.errors = append(m.errors, fmt.Sprintf(format, args...))
+}
+
+var (
+	y2k = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	factory = RecordFactory{
+		EventName:         "event",
+		Timestamp:         y2k,
+		ObservedTimestamp: y2k.Add(time.Second),
+		Severity:          log.SeverityInfo,
+		SeverityText:      "INFO",
+		Body:              attribute.StringValue("body"),
+		Attributes:        []attribute.KeyValue{attribute.String("a", "1"), attribute.Int("b", 2)},
+		TraceID:           trace.TraceID{1},
+		SpanID:            trace.SpanID{1},
+		TraceFlags:        trace.FlagsSampled,
+		Resource:          resource.NewSchemaless(attribute.String("service.name", "test")),
+		InstrumentationScope: &instrumentation.Scope{
+			Name:       "scope",
+			Version:    "v1",
+			Attributes: attribute.NewSet(attribute.Bool("x", true)),
+		},
+	}
+)
+
+func TestAssertRecordEqual(t *testing.T) {
+	want := factory.NewRecord()
+
+	reordered := factory
+	reordered.Attributes = []attribute.KeyValue{attribute.Int("b", 2), attribute.String("a", "1")}
+	m := new(mockTestingT)
+	assert.True(t, AssertRecordEqual(m, want, reordered.NewRecord()), "attribute order is ignored")
+	assert.Empty(t, m.errors)
+
+	tests := []struct {
+		name   string
+		modify func(*RecordFactory)
+		opts   []AssertOption
+		want   []string
+	}{
+		{
+			name:   "Timestamp",
+			modify: func(f *RecordFactory) { f.Timestamp = y2k.Add(time.Hour) },
+			want:   []string{"timestamp: expected 2000-01-01 00:00:00 +0000 UTC, got 2000-01-01 01:00:00 +0000 UTC"},
+		},
+		{
+			name:   "IgnoreTimestamp",
+			modify: func(f *RecordFactory) { f.Timestamp = y2k.Add(time.Hour) },
+			opts:   []AssertOption{IgnoreTimestamp()},
+		},
+		{
+			name:   "IgnoreObservedTimestamp",
+			modify: func(f *RecordFactory) { f.ObservedTimestamp = time.Now() },
+			opts:   []AssertOption{IgnoreObservedTimestamp()},
+		},
+		{
+			name:   "Severity",
+			modify: func(f *RecordFactory) { f.Severity = log.SeverityWarn },
+			want:   []string{"severity: expected INFO, got WARN"},
+		},
+		{
+			name:   "Body",
+			modify: func(f *RecordFactory) { f.Body = attribute.IntValue(1) },
+			want:   []string{`body: expected "body", got 1`},
+		},
+		{
+			name: "Attributes",
+			modify: func(f *RecordFactory) {
+				f.Attributes = []attribute.KeyValue{attribute.String("a", "2"), attribute.String("c", "3")}
+			},
+			want: []string{
+				`attribute a: expected "1", got "2"`,
+				"missing attribute b=2",
+				`unexpected attribute c="3"`,
+			},
+		},
+		{
+			name:   "TraceContext",
+			modify: func(f *RecordFactory) { f.SpanID = trace.SpanID{2} },
+			want:   []string{"span ID: expected 0100000000000000, got 0200000000000000"},
+		},
+		{
+			name:   "Resource",
+			modify: func(f *RecordFactory) { f.Resource = nil },
+			want:   []string{"resource: expected {service.name=test}, got <nil>"},
+		},
+		{
+			name: "Scope",
+			modify: func(f *RecordFactory) {
+				f.InstrumentationScope = &instrumentation.Scope{Name: "other"}
+			},
+			want: []string{
+				`instrumentation scope: expected {name="scope" version="v1" schemaURL="" attributes={x=true}}, ` +
+					`got {name="other" version="" schemaURL="" attributes={}}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := factory
+			tt.modify(&f)
+
+			m := new(mockTestingT)
+			ok := AssertRecordEqual(m, want, f.NewRecord(), tt.opts...)
+			if len(tt.want) == 0 {
+				assert.True(t, ok)
+				assert.Empty(t, m.errors)
+				return
+			}
+			assert.False(t, ok)
+			require.Len(t, m.errors, 1)
+			for _, w := range tt.want {
+				assert.Contains(t, m.errors[0], "\n\t"+w)
+			}
+		})
+	}
+}
+
+func TestAssertRecordsEqual(t *testing.T) {
+	r0 := factory.NewRecord()
+	f := factory
+	f.Body = attribute.StringValue("other")
+	r1 := f.NewRecord()
+
+	m := new(mockTestingT)
+	assert.True(t, AssertRecordsEqual(m, []sdklog.Record{r0, r1}, []sdklog.Record{r0, r1}))
+	assert.True(t, AssertRecordsEqual(m, nil, []sdklog.Record{}))
+	assert.Empty(t, m.errors)
+
+	assert.False(t, AssertRecordsEqual(m, []sdklog.Record{r0, r1}, []sdklog.Record{r1}))
+	require.Len(t, m.errors, 1)
+	assert.Contains(t, m.errors[0], "\n\texpected 2 log records, got 1")
+	assert.Contains(t, m.errors[0], "\n\trecord 0: body: expected \"body\", got \"other\"")
+}
@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log"
 	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 func ExampleRecordFactory() {
@@ -62,3 +65,42 @@ func (exporter) Shutdown(context.Context) error {
 func (exporter) ForceFlush(context.Context) error {
 	return nil
 }
+
+func ExampleInMemoryExporter() {
+	ctx := context.Background()
+
+	exp := logtest.NewInMemoryExporter()
+	provider := log.NewLoggerProvider(
+		log.WithResource(resource.Empty()),
+		log.WithProcessor(log.NewSimpleProcessor(exp)),
+	)
+
+	var r otellog.Record
+	r.SetBody(attribute.StringValue("hello"))
+	r.SetTimestamp(time.Now())
+	provider.Logger("myapp").Emit(ctx, r)
+
+	// Shut down the provider to flush its processors.
+	_ = provider.Shutdown(ctx)
+
+	want := logtest.RecordFactory{
+		Body:                 attribute.StringValue("hello"),
+		InstrumentationScope: &instrumentation.Scope{Name: "myapp"},
+	}.NewRecord()
+
+	// In a test, pass the *testing.T instead.
+	t := printT{}
+	for _, got := range exp.ScopeRecords("myapp") {
+		ok := logtest.AssertRecordEqual(t, want, got, logtest.IgnoreTimestamp(), logtest.IgnoreObservedTimestamp())
+		fmt.Println(ok)
+	}
+
+	// Output:
+	// true
+}
+
+type printT struct{}
+
+func (printT) Errorf(format string, args ...any) {
+	fmt.Printf(format+"\n", args...)
+}
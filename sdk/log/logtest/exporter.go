@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ sdklog.Exporter = (*InMemoryExporter)(nil)
+
+// NewInMemoryExporter returns a new InMemoryExporter.
+func NewInMemoryExporter() *InMemoryExporter {
+	return new(InMemoryExporter)
+}
+
+// InMemoryExporter is an [sdklog.Exporter] that stores all exported log
+// records in memory. The records have the resource, instrumentation scope,
+// trace context, and limits applied by the SDK.
+//
+// Use it with a [sdklog.LoggerProvider] to test processors, or the log
+// records emitted by instrumentation as they are exported.
+type InMemoryExporter struct {
+	mu       sync.Mutex
+	records  []sdklog.Record
+	shutdown bool
+}
+
+// Export stores clones of records in memory.
+//
+// It returns [sdklog.ErrExporterShutdown] if called after Shutdown.
+func (e *InMemoryExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.shutdown {
+		return sdklog.ErrExporterShutdown
+	}
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+// Shutdown stops the exporter from storing log records. The log records
+// stored before are kept, so they can be inspected after shutting down a
+// [sdklog.LoggerProvider] to flush its processors.
+func (e *InMemoryExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return nil
+}
+
+// ForceFlush does nothing. The exporter holds no log records to flush.
+func (*InMemoryExporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Reset clears the log records stored in memory.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = nil
+}
+
+// Records returns the log records stored in memory, in the order they were
+// exported.
+func (e *InMemoryExporter) Records() []sdklog.Record {
+	return e.Filter(func(sdklog.Record) bool { return true })
+}
+
+// Filter returns the log records stored in memory that f returns true for, in
+// the order they were exported.
+func (e *InMemoryExporter) Filter(f func(sdklog.Record) bool) []sdklog.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var out []sdklog.Record
+	for _, r := range e.records {
+		if f(r) {
+			out = append(out, r.Clone())
+		}
+	}
+	return out
+}
+
+// ScopeRecords returns the log records stored in memory that were emitted by
+// a logger of the instrumentation scope named name.
+func (e *InMemoryExporter) ScopeRecords(name string) []sdklog.Record {
+	return e.Filter(func(r sdklog.Record) bool {
+		return r.InstrumentationScope().Name == name
+	})
+}
+
+// SpanRecords returns the log records stored in memory that were emitted in
+// the span of sc.
+func (e *InMemoryExporter) SpanRecords(sc trace.SpanContext) []sdklog.Record {
+	return e.Filter(func(r sdklog.Record) bool {
+		return r.TraceID() == sc.TraceID() && r.SpanID() == sc.SpanID()
+	})
+}
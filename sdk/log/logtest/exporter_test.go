@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func emit(ctx context.Context, l log.Logger, body string, attrs ...attribute.KeyValue) {
+	var r log.Record
+	r.SetBody(attribute.StringValue(body))
+	r.AddAttributes(attrs...)
+	l.Emit(ctx, r)
+}
+
+func TestInMemoryExporter(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("service.name", "test"))
+	exp := NewInMemoryExporter()
+	lp := sdklog.NewLoggerProvider(
+		sdklog.WithResource(res),
+		sdklog.WithProcessor(sdklog.NewSimpleProcessor(exp)),
+		sdklog.WithAttributeValueLengthLimit(3),
+	)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(t.Context(), sc)
+
+	emit(ctx, lp.Logger("a"), "first", attribute.String("key", "value"))
+	emit(t.Context(), lp.Logger("b"), "second")
+	emit(ctx, lp.Logger("b"), "third")
+	require.NoError(t, lp.Shutdown(t.Context()))
+
+	got := exp.Records()
+	require.Len(t, got, 3)
+	assert.Equal(t, "first", got[0].Body().AsString())
+	assert.Equal(t, res, got[0].Resource())
+	assert.Equal(t, "a", got[0].InstrumentationScope().Name)
+	assert.Equal(t, sc.TraceID(), got[0].TraceID())
+	assert.Equal(t, sc.SpanID(), got[0].SpanID())
+	assert.Equal(t, sc.TraceFlags(), got[0].TraceFlags())
+	got[0].WalkAttributes(func(kv attribute.KeyValue) bool {
+		assert.Equal(t, attribute.String("key", "val"), kv, "limits are applied")
+		return true
+	})
+
+	bodies := func(records []sdklog.Record) []string {
+		var out []string
+		for _, r := range records {
+			out = append(out, r.Body().AsString())
+		}
+		return out
+	}
+	assert.Equal(t, []string{"second", "third"}, bodies(exp.ScopeRecords("b")))
+	assert.Equal(t, []string{"first", "third"}, bodies(exp.SpanRecords(sc)))
+	assert.Equal(t, []string{"third"}, bodies(exp.Filter(func(r sdklog.Record) bool {
+		return r.Body().AsString() == "third"
+	})))
+	assert.Empty(t, exp.ScopeRecords("c"))
+
+	exp.Reset()
+	assert.Empty(t, exp.Records())
+}
+
+func TestInMemoryExporterClonesRecords(t *testing.T) {
+	exp := NewInMemoryExporter()
+	r := RecordFactory{Body: attribute.StringValue("original")}.NewRecord()
+	require.NoError(t, exp.Export(t.Context(), []sdklog.Record{r}))
+
+	r.SetBody(attribute.StringValue("modified"))
+	got := exp.Records()
+	require.Len(t, got, 1)
+	assert.Equal(t, "original", got[0].Body().AsString())
+
+	got[0].SetBody(attribute.StringValue("modified"))
+	assert.Equal(t, "original", exp.Records()[0].Body().AsString())
+}
+
+func TestInMemoryExporterShutdown(t *testing.T) {
+	exp := NewInMemoryExporter()
+	records := []sdklog.Record{RecordFactory{}.NewRecord()}
+	require.NoError(t, exp.Export(t.Context(), records))
+
+	assert.NoError(t, exp.ForceFlush(t.Context()))
+	assert.NoError(t, exp.Shutdown(t.Context()))
+	assert.NoError(t, exp.Shutdown(t.Context()))
+	assert.ErrorIs(t, exp.Export(t.Context(), records), sdklog.ErrExporterShutdown)
+	assert.Len(t, exp.Records(), 1, "records are kept after shutdown")
+}
+
+func TestInMemoryExporterContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	exp := NewInMemoryExporter()
+	assert.ErrorIs(t, exp.Export(ctx, []sdklog.Record{RecordFactory{}.NewRecord()}), context.Canceled)
+	assert.Empty(t, exp.Records())
+}
+
+func TestInMemoryExporterConcurrentSafe(t *testing.T) {
+	exp := NewInMemoryExporter()
+	records := []sdklog.Record{RecordFactory{}.NewRecord()}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 10 {
+			_ = exp.Export(t.Context(), records)
+		}
+	}()
+	for range 10 {
+		_ = exp.Records()
+		_ = exp.ScopeRecords("")
+		exp.Reset()
+	}
+	<-done
+}
@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+const (
+	metricsScopeName = "go.opentelemetry.io/otel/sdk/log"
+
+	dfltRecordCounterName = "log.record.count"
+
+	scopeNameKey = semconv.OTelScopeNameKey
+	severityKey  = attribute.Key("log.record.severity")
+)
+
+// This is a compile-time check that MetricsProcessor implements Processor.
+var _ Processor = (*MetricsProcessor)(nil)
+
+// MetricsProcessor is a Processor that records metrics about log records. It
+// counts the log records by instrumentation scope, severity level, and the
+// attributes selected with [WithMetricAttributeFilter]. It can also record a
+// numeric value of log records in a histogram, see [WithValueHistogram].
+//
+// The measurements have the "otel.scope.name" attribute, the instrumentation
+// scope name, and the "log.record.severity" attribute, the severity level of
+// the log record: UNDEFINED, TRACE, DEBUG, INFO, WARN, ERROR, or FATAL. The
+// severity level is the base severity of the log record, e.g. ERROR for both
+// the ERROR and ERROR2 severities.
+//
+// MetricsProcessor does not pass the log records to other processors.
+// Register it with a [LoggerProvider] in addition to the processors that
+// export log records.
+//
+// By default, MetricsProcessor is enabled for all log records, so that all
+// of them are measured. As a [LoggerProvider] is enabled for a log record if
+// any of its processors is, registering a MetricsProcessor makes the Enabled
+// method of all its loggers return true. Log bridges then build all log
+// records, including the ones the other processors, e.g. a
+// [FilterProcessor], do not export. Use [WithMeasureEnabledOnly] to only
+// measure the log records the other processors are enabled for.
+//
+// Use [NewMetricsProcessor] to create a MetricsProcessor.
+type MetricsProcessor struct {
+	enabled    bool
+	filter     attribute.Filter
+	counter    metric.Int64Counter
+	histograms []valueHistogram
+}
+
+type valueHistogram struct {
+	key  attribute.Key
+	inst metric.Float64Histogram
+}
+
+// NewMetricsProcessor returns a new MetricsProcessor that records its metrics
+// with a meter of provider.
+//
+// Errors creating the instruments are sent to the OTel error handler, and
+// the corresponding metrics are not recorded.
+func NewMetricsProcessor(provider metric.MeterProvider, opts ...MetricsProcessorOption) *MetricsProcessor {
+	cfg := metricsConfig{counterName: dfltRecordCounterName}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	meter := provider.Meter(metricsScopeName, metric.WithInstrumentationVersion(sdk.Version()))
+	p := &MetricsProcessor{enabled: !cfg.enabledOnly, filter: cfg.filter}
+
+	var err error
+	p.counter, err = meter.Int64Counter(
+		cfg.counterName,
+		metric.WithDescription("The number of log records emitted."),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		otel.Handle(fmt.Errorf("failed to create log record counter: %w", err))
+	}
+	for _, h := range cfg.histograms {
+		opts := []metric.Float64HistogramOption{metric.WithUnit(h.unit)}
+		if h.key == "" {
+			opts = append(opts, metric.WithDescription("The numeric body of log records."))
+		} else {
+			opts = append(opts, metric.WithDescription(fmt.Sprintf("The %s attribute of log records.", h.key)))
+		}
+		inst, err := meter.Float64Histogram(h.name, opts...)
+		if err != nil {
+			otel.Handle(fmt.Errorf("failed to create log record histogram %s: %w", h.name, err))
+		}
+		if inst != nil {
+			p.histograms = append(p.histograms, valueHistogram{key: h.key, inst: inst})
+		}
+	}
+	return p
+}
+
+// Enabled returns true, unless [WithMeasureEnabledOnly] is used, in which
+// case it returns false.
+func (p *MetricsProcessor) Enabled(context.Context, EnabledParameters) bool {
+	return p.enabled
+}
+
+// OnEmit records the metrics of record.
+func (p *MetricsProcessor) OnEmit(ctx context.Context, record *Record) error {
+	attrs := []attribute.KeyValue{
+		scopeNameKey.String(record.InstrumentationScope().Name),
+		severityKey.String(severityLevelNames[severityLevel(record.Severity())]),
+	}
+	values := make([]attribute.Value, len(p.histograms))
+	record.WalkAttributes(func(kv attribute.KeyValue) bool {
+		if p.filter != nil && p.filter(kv) {
+			attrs = append(attrs, kv)
+		}
+		for i, h := range p.histograms {
+			if h.key != "" && h.key == kv.Key {
+				values[i] = kv.Value
+			}
+		}
+		return true
+	})
+	opt := metric.WithAttributes(attrs...)
+
+	if p.counter != nil {
+		p.counter.Add(ctx, 1, opt)
+	}
+	for i, h := range p.histograms {
+		v := values[i]
+		if h.key == "" {
+			v = record.Body()
+		}
+		switch v.Type() {
+		case attribute.INT64:
+			h.inst.Record(ctx, float64(v.AsInt64()), opt)
+		case attribute.FLOAT64:
+			h.inst.Record(ctx, v.AsFloat64(), opt)
+		}
+	}
+	return nil
+}
+
+// Shutdown does nothing. The metrics are exported by the MeterProvider they
+// are recorded with.
+func (*MetricsProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush does nothing. The metrics are exported by the MeterProvider
+// they are recorded with.
+func (*MetricsProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+type metricsConfig struct {
+	counterName string
+	enabledOnly bool
+	filter      attribute.Filter
+	histograms  []histogramConfig
+}
+
+type histogramConfig struct {
+	name, unit string
+	key        attribute.Key
+}
+
+// MetricsProcessorOption applies a configuration to a [MetricsProcessor].
+type MetricsProcessorOption interface {
+	apply(metricsConfig) metricsConfig
+}
+
+type metricsOptionFunc func(metricsConfig) metricsConfig
+
+func (fn metricsOptionFunc) apply(c metricsConfig) metricsConfig {
+	return fn(c)
+}
+
+// WithRecordCounterName sets the name of the counter of log records of a
+// [MetricsProcessor].
+//
+// By default, "log.record.count" is used. The default value is also used
+// when the provided value is empty.
+func WithRecordCounterName(name string) MetricsProcessorOption {
+	return metricsOptionFunc(func(cfg metricsConfig) metricsConfig {
+		if name != "" {
+			cfg.counterName = name
+		}
+		return cfg
+	})
+}
+
+// WithMeasureEnabledOnly makes a [MetricsProcessor] only measure the log
+// records emitted when other processors of the [LoggerProvider] are enabled.
+// The Enabled method of the MetricsProcessor then returns false, so that it
+// does not change whether the loggers of the LoggerProvider are enabled.
+//
+// Log records emitted without checking whether a logger is enabled are still
+// measured.
+//
+// By default, the MetricsProcessor is enabled for all log records.
+func WithMeasureEnabledOnly() MetricsProcessorOption {
+	return metricsOptionFunc(func(cfg metricsConfig) metricsConfig {
+		cfg.enabledOnly = true
+		return cfg
+	})
+}
+
+// WithMetricAttributeFilter sets the filter that selects the attributes of
+// log records a [MetricsProcessor] adds to its measurements. Only attributes
+// with a low number of distinct values should be selected, to limit the
+// cardinality of the metrics.
+//
+// By default, no attributes of log records are added.
+func WithMetricAttributeFilter(filter attribute.Filter) MetricsProcessorOption {
+	return metricsOptionFunc(func(cfg metricsConfig) metricsConfig {
+		cfg.filter = filter
+		return cfg
+	})
+}
+
+// WithValueHistogram makes a [MetricsProcessor] record a numeric value of log
+// records in a histogram named name with the unit unit. The value is the
+// attribute of log records with the key, or their body if key is empty. Log
+// records without an int64 or float64 value are not recorded.
+//
+// This option can be passed multiple times to record multiple values. By
+// default, no histograms are recorded.
+func WithValueHistogram(name, unit string, key attribute.Key) MetricsProcessorOption {
+	return metricsOptionFunc(func(cfg metricsConfig) metricsConfig {
+		cfg.histograms = append(cfg.histograms, histogramConfig{name: name, unit: unit, key: key})
+		return cfg
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func newMetricsProcessor(t *testing.T, opts ...MetricsProcessorOption) (*MetricsProcessor, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { require.NoError(t, mp.Shutdown(context.Background())) })
+	return NewMetricsProcessor(mp, opts...), reader
+}
+
+func metricRecord(scope string, severity log.Severity, body attribute.Value, attrs ...attribute.KeyValue) *Record {
+	r := &Record{scope: &instrumentation.Scope{Name: scope}, attributeCountLimit: -1, attributeValueLengthLimit: -1}
+	r.SetSeverity(severity)
+	r.SetBody(body)
+	r.AddAttributes(attrs...)
+	return r
+}
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) []metricdata.Metrics {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, metricsScopeName, rm.ScopeMetrics[0].Scope.Name)
+	return rm.ScopeMetrics[0].Metrics
+}
+
+func TestMetricsProcessorCounter(t *testing.T) {
+	p, reader := newMetricsProcessor(t, WithMetricAttributeFilter(attribute.NewAllowKeysFilter("method")))
+	ctx := t.Context()
+
+	get := attribute.String("method", "GET")
+	require.NoError(t, p.OnEmit(ctx, metricRecord("a", log.SeverityError, attribute.Value{}, get)))
+	require.NoError(t, p.OnEmit(ctx, metricRecord("a", log.SeverityError3, attribute.Value{}, get)))
+	r := metricRecord("a", log.SeverityError, attribute.Value{}, get, attribute.Int("id", 1))
+	require.NoError(t, p.OnEmit(ctx, r))
+	require.NoError(t, p.OnEmit(ctx, metricRecord("a", log.SeverityError, attribute.Value{})))
+	require.NoError(t, p.OnEmit(ctx, metricRecord("b", log.SeverityDebug, attribute.Value{})))
+	require.NoError(t, p.OnEmit(ctx, metricRecord("b", log.SeverityUndefined, attribute.Value{})))
+
+	want := metricdata.Metrics{
+		Name:        dfltRecordCounterName,
+		Description: "The number of log records emitted.",
+		Unit:        "{record}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{
+					Attributes: attribute.NewSet(scopeNameKey.String("a"), severityKey.String("ERROR"), get),
+					Value:      3,
+				},
+				{
+					Attributes: attribute.NewSet(scopeNameKey.String("a"), severityKey.String("ERROR")),
+					Value:      1,
+				},
+				{
+					Attributes: attribute.NewSet(scopeNameKey.String("b"), severityKey.String("DEBUG")),
+					Value:      1,
+				},
+				{
+					Attributes: attribute.NewSet(scopeNameKey.String("b"), severityKey.String("UNDEFINED")),
+					Value:      1,
+				},
+			},
+		},
+	}
+	got := collect(t, reader)
+	require.Len(t, got, 1)
+	metricdatatest.AssertEqual(t, want, got[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestMetricsProcessorHistogram(t *testing.T) {
+	p, reader := newMetricsProcessor(
+		t,
+		WithRecordCounterName("records"),
+		WithValueHistogram("duration", "s", "duration"),
+		WithValueHistogram("body", "By", ""),
+	)
+	ctx := t.Context()
+
+	dur := func(v float64) attribute.KeyValue { return attribute.Float64("duration", v) }
+	require.NoError(t, p.OnEmit(ctx, metricRecord("a", log.SeverityInfo, attribute.IntValue(10), dur(1.5))))
+	require.NoError(t, p.OnEmit(ctx, metricRecord("a", log.SeverityInfo, attribute.StringValue("10"), dur(0.5))))
+	require.NoError(t, p.OnEmit(ctx, metricRecord("a", log.SeverityInfo, attribute.Float64Value(2.5))))
+	require.NoError(t, p.OnEmit(
+		ctx,
+		metricRecord("a", log.SeverityInfo, attribute.Value{}, attribute.String("duration", "1s")),
+	))
+
+	got := collect(t, reader)
+	require.Len(t, got, 3)
+	assert.Equal(t, "records", got[0].Name)
+
+	histogram := func(m metricdata.Metrics) (uint64, float64) {
+		t.Helper()
+		h, ok := m.Data.(metricdata.Histogram[float64])
+		require.True(t, ok, "histogram")
+		require.Len(t, h.DataPoints, 1)
+		return h.DataPoints[0].Count, h.DataPoints[0].Sum
+	}
+	assert.Equal(t, "duration", got[1].Name)
+	assert.Equal(t, "s", got[1].Unit)
+	assert.Equal(t, "The duration attribute of log records.", got[1].Description)
+	count, sum := histogram(got[1])
+	assert.Equal(t, uint64(2), count)
+	assert.InDelta(t, 2.0, sum, 0)
+
+	assert.Equal(t, "body", got[2].Name)
+	count, sum = histogram(got[2])
+	assert.Equal(t, uint64(2), count)
+	assert.InDelta(t, 12.5, sum, 0)
+}
+
+func TestMetricsProcessorNoop(t *testing.T) {
+	p, _ := newMetricsProcessor(t)
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{}))
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
+
+func TestMetricsProcessorMeasureEnabledOnly(t *testing.T) {
+	p, reader := newMetricsProcessor(t, WithMeasureEnabledOnly())
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+
+	filter := newProcessor("filter")
+	filter.enabledFunc = func(_ context.Context, param EnabledParameters) bool {
+		return param.Severity >= log.SeverityWarn
+	}
+	provider := NewLoggerProvider(WithProcessor(filter), WithProcessor(p))
+	l := provider.Logger("scope")
+	ctx := t.Context()
+	assert.False(t, l.Enabled(ctx, log.EnabledParameters{Severity: log.SeverityDebug}))
+	assert.True(t, l.Enabled(ctx, log.EnabledParameters{Severity: log.SeverityWarn}))
+
+	var r log.Record
+	r.SetSeverity(log.SeverityWarn)
+	l.Emit(ctx, r)
+
+	got := collect(t, reader)
+	require.Len(t, got, 1)
+	sum, ok := got[0].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+}
@@ -33,6 +33,9 @@ type providerConfig struct {
 	processors    []Processor
 	attrCntLim    setting[int]
 	attrValLenLim setting[int]
+	bodyLenLim    setting[int]
+	valDepthLim   setting[int]
+	truncatedAttr setting[bool]
 	allowDupKeys  setting[bool]
 }
 
@@ -79,6 +82,9 @@ type LoggerProvider struct {
 	processors                []Processor
 	attributeCountLimit       int
 	attributeValueLengthLimit int
+	bodyLengthLimit           int
+	valueDepthLimit           int
+	truncatedAttr             bool
 	allowDupKeys              bool
 
 	loggersMu sync.Mutex
@@ -109,6 +115,9 @@ func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
 		processors:                cfg.processors,
 		attributeCountLimit:       cfg.attrCntLim.Value,
 		attributeValueLengthLimit: cfg.attrValLenLim.Value,
+		bodyLengthLimit:           cfg.bodyLenLim.Value,
+		valueDepthLimit:           cfg.valDepthLim.Value,
+		truncatedAttr:             cfg.truncatedAttr.Value,
 		allowDupKeys:              cfg.allowDupKeys.Value,
 	}
 }
@@ -348,6 +357,57 @@ func WithAttributeValueLengthLimit(limit int) LoggerProviderOption {
 	})
 }
 
+// WithBodyLengthLimit sets the maximum allowed length of the log record body.
+//
+// The limit applies to string and byte slice body values, and recursively to
+// the values within string slice, slice, and map body values. Strings and byte
+// slices longer than this value will be truncated to this length.
+//
+// Setting this to zero or a negative value means no limit is applied.
+//
+// By default, if this option is not passed, no limit is applied.
+func WithBodyLengthLimit(limit int) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.bodyLenLim = newSetting(limit)
+		return cfg
+	})
+}
+
+// WithValueDepthLimit sets the maximum allowed nesting depth of slice and map
+// values in the log record body and attribute values.
+//
+// A slice or map value is at the depth of one, and the slice and map values it
+// contains are at the depth of two, and so on. Slice and map values nested
+// deeper than this limit will be replaced by an empty value.
+//
+// Setting this to zero or a negative value means no limit is applied.
+//
+// By default, if this option is not passed, no limit is applied.
+func WithValueDepthLimit(limit int) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.valDepthLim = newSetting(limit)
+		return cfg
+	})
+}
+
+// WithTruncatedAttribute sets the LoggerProvider to add the [TruncatedKey]
+// attribute, with the value true, to log records with a body or attribute
+// values truncated due to the limits set with [WithBodyLengthLimit],
+// [WithValueDepthLimit], and [WithAttributeValueLengthLimit].
+//
+// The attribute is subject to the attribute count limit. If it is dropped
+// because of that limit, the dropped attributes count of the log record is
+// incremented instead.
+//
+// By default, if this option is not passed, truncated log records are not
+// marked.
+func WithTruncatedAttribute() LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.truncatedAttr = newSetting(true)
+		return cfg
+	})
+}
+
 // WithAllowKeyDuplication sets whether deduplication is skipped for log record
 // and instrumentation scope key-value collections.
 //
@@ -196,6 +196,8 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 	p0, p1 := newProcessor("0"), newProcessor("1")
 	attrCntLim := 12
 	attrValLenLim := 21
+	bodyLenLim := 1024
+	valDepthLim := 4
 
 	testcases := []struct {
 		name    string
@@ -219,6 +221,9 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				WithProcessor(p1),
 				WithAttributeCountLimit(attrCntLim),
 				WithAttributeValueLengthLimit(attrValLenLim),
+				WithBodyLengthLimit(bodyLenLim),
+				WithValueDepthLimit(valDepthLim),
+				WithTruncatedAttribute(),
 				WithAllowKeyDuplication(),
 			},
 			want: &LoggerProvider{
@@ -226,6 +231,9 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				processors:                []Processor{p0, p1},
 				attributeCountLimit:       attrCntLim,
 				attributeValueLengthLimit: attrValLenLim,
+				bodyLengthLimit:           bodyLenLim,
+				valueDepthLimit:           valDepthLim,
+				truncatedAttr:             true,
 				allowDupKeys:              true,
 			},
 		},
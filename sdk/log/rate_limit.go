@@ -0,0 +1,374 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"hash/maphash"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// SuppressedCountKey is the attribute key of the number of log records that
+// were suppressed by a [RateLimitProcessor], added to its summary log
+// records.
+const SuppressedCountKey attribute.Key = "otel.log.suppressed_count"
+
+const (
+	dfltRateLimit       = 10
+	dfltRateBurst       = 100
+	dfltSummaryInterval = 10 * time.Second
+	dfltMaxRateKeys     = 1024
+
+	// maxRateKeyBodyLen is the maximum length of the prefix of a string body
+	// used as the default rate limit key.
+	maxRateKeyBodyLen = 128
+)
+
+// This is a compile-time check that RateLimitProcessor implements Processor.
+var _ Processor = (*RateLimitProcessor)(nil)
+
+// RateLimitProcessor is a Processor that limits the rate of identical log
+// records passed to the Processor it wraps. Log records are identical if they
+// have the same key, instrumentation scope name, and severity. That way, a
+// hot loop emitting the same log record cannot evict the other log records
+// from the queue of a [BatchProcessor].
+//
+// The key of a log record is its event name, the beginning of its body if the
+// body is a string, or a hash of its body otherwise. Log records that only
+// differ by the end of their message, such as an ID, are identical. Log
+// records without an event name and with an empty body have no key and are
+// not rate limited. Use [WithRateLimitKey] to identify log records
+// differently, for example by the message template of a logging library.
+//
+// The rate of each set of identical log records is limited by a token bucket.
+// The log records emitted when the bucket is empty are suppressed. Once per
+// summary interval, a summary log record is passed for each set of identical
+// log records that had suppressed log records. It is a copy of the first
+// suppressed log record, with its observed timestamp set to the time of the
+// summary and the number of suppressed log records as the
+// [SuppressedCountKey] attribute.
+//
+// Use [NewRateLimitProcessor] to create a RateLimitProcessor.
+type RateLimitProcessor struct {
+	processor Processor
+	cfg       rateLimitConfig
+	now       func() time.Time
+
+	mu      sync.Mutex
+	buckets map[rateKey]*rateBucket
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+type rateKey struct {
+	key      string
+	scope    string
+	severity log.Severity
+	// overflow is true for the bucket shared by all the log records of the
+	// scope and severity once the maximum number of keys is reached.
+	overflow bool
+}
+
+// rateBucket is the token bucket of a set of identical log records.
+type rateBucket struct {
+	tokens float64
+	last   time.Time
+
+	suppressed uint64
+	// first is the first log record suppressed since the last summary.
+	first Record
+}
+
+// NewRateLimitProcessor returns a new RateLimitProcessor that passes the log
+// records within the rate limit to processor, and the summaries of the
+// suppressed ones.
+//
+// It starts a goroutine that passes the summaries. It is stopped by calling
+// Shutdown.
+func NewRateLimitProcessor(processor Processor, opts ...RateLimitProcessorOption) *RateLimitProcessor {
+	cfg := rateLimitConfig{
+		rate:     dfltRateLimit,
+		burst:    dfltRateBurst,
+		interval: dfltSummaryInterval,
+		maxKeys:  dfltMaxRateKeys,
+		key:      defaultRateKey,
+	}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	p := &RateLimitProcessor{
+		processor: processor,
+		cfg:       cfg,
+		now:       time.Now,
+		buckets:   make(map[rateKey]*rateBucket),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+func (p *RateLimitProcessor) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.cfg.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			if err := p.summarize(context.Background()); err != nil {
+				otel.Handle(err)
+			}
+		}
+	}
+}
+
+// Enabled returns the result of Enabled of the wrapped Processor. The body of
+// a log record is not known in advance, so the rate limit is only applied by
+// OnEmit.
+func (p *RateLimitProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the wrapped Processor if it is within the rate
+// limit. Otherwise, record is suppressed and counted in the next summary.
+func (p *RateLimitProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if !p.allow(record) {
+		return nil
+	}
+	return p.processor.OnEmit(ctx, record)
+}
+
+// allow reports whether r is within the rate limit, and takes a token from
+// its bucket if it is.
+func (p *RateLimitProcessor) allow(r *Record) bool {
+	k := p.cfg.key(r)
+	if k == "" {
+		// The log record cannot be identified.
+		return true
+	}
+	key := rateKey{
+		key:      k,
+		scope:    r.InstrumentationScope().Name,
+		severity: r.Severity(),
+	}
+	now := p.now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b, ok := p.buckets[key]
+	if !ok {
+		if len(p.buckets) >= p.cfg.maxKeys {
+			// Too many distinct log records to track, share a bucket with
+			// all the other untracked ones of the scope and severity.
+			key = rateKey{scope: key.scope, severity: key.severity, overflow: true}
+			b, ok = p.buckets[key]
+		}
+		if !ok {
+			b = &rateBucket{tokens: float64(p.cfg.burst), last: now}
+			p.buckets[key] = b
+		}
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * p.cfg.rate
+	b.tokens = min(b.tokens, float64(p.cfg.burst))
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true
+	}
+
+	if b.suppressed == 0 {
+		b.first = r.Clone()
+	}
+	b.suppressed++
+	return false
+}
+
+// summarize passes the summaries of the suppressed log records to the
+// wrapped Processor. It also forgets the buckets that are full, as they are
+// the same as new ones.
+func (p *RateLimitProcessor) summarize(ctx context.Context) error {
+	now := p.now()
+
+	p.mu.Lock()
+	var summaries []Record
+	for key, b := range p.buckets {
+		if b.suppressed > 0 {
+			r := b.first
+			r.SetObservedTimestamp(now)
+			count := int64(b.suppressed) //nolint:gosec // Count never overflows.
+			r.AddAttributes(SuppressedCountKey.Int64(count))
+			summaries = append(summaries, r)
+			b.suppressed, b.first = 0, Record{}
+		}
+		tokens := b.tokens + now.Sub(b.last).Seconds()*p.cfg.rate
+		if tokens >= float64(p.cfg.burst) {
+			delete(p.buckets, key)
+		}
+	}
+	p.mu.Unlock()
+
+	var errs []error
+	for i := range summaries {
+		if err := p.processor.OnEmit(ctx, &summaries[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Shutdown passes the pending summaries to the wrapped Processor, and shuts
+// it down. Calls after the first one do nothing.
+func (p *RateLimitProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		close(p.stop)
+		select {
+		case <-p.done:
+			err = p.summarize(ctx)
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		err = errors.Join(err, p.processor.Shutdown(ctx))
+	})
+	return err
+}
+
+// ForceFlush passes the pending summaries to the wrapped Processor, and
+// flushes it.
+func (p *RateLimitProcessor) ForceFlush(ctx context.Context) error {
+	return errors.Join(p.summarize(ctx), p.processor.ForceFlush(ctx))
+}
+
+// rateKeySeed is the seed of the hashes of the bodies used as rate limit keys.
+var rateKeySeed = maphash.MakeSeed()
+
+// defaultRateKey returns the event name of r, the beginning of its body if it
+// is a string, or a hash of its body otherwise. An empty string is returned if
+// r has no event name and an empty body.
+func defaultRateKey(r *Record) string {
+	if name := r.EventName(); name != "" {
+		return name
+	}
+	body := r.Body()
+	switch body.Type() {
+	case attribute.EMPTY:
+		return ""
+	case attribute.STRING:
+		s := body.AsString()
+		if len(s) > maxRateKeyBodyLen {
+			s = s[:maxRateKeyBodyLen]
+		}
+		return s
+	default:
+		// Structured bodies are only identical if they are equal. Hash them to
+		// bound the size of the key.
+		h := maphash.String(rateKeySeed, body.Emit())
+		return body.Type().String() + ":" + strconv.FormatUint(h, 16)
+	}
+}
+
+type rateLimitConfig struct {
+	rate     float64
+	burst    int
+	interval time.Duration
+	maxKeys  int
+	key      func(*Record) string
+}
+
+// RateLimitProcessorOption applies a configuration to a
+// [RateLimitProcessor].
+type RateLimitProcessorOption interface {
+	apply(rateLimitConfig) rateLimitConfig
+}
+
+type rateLimitOptionFunc func(rateLimitConfig) rateLimitConfig
+
+func (fn rateLimitOptionFunc) apply(c rateLimitConfig) rateLimitConfig {
+	return fn(c)
+}
+
+// WithRateLimit sets the number of identical log records per second passed
+// by a [RateLimitProcessor], and the burst of identical log records passed
+// before the rate applies.
+//
+// By default, 10 identical log records per second are passed, with a burst
+// of 100. The default values are also used when the provided values are not
+// positive.
+func WithRateLimit(perSecond float64, burst int) RateLimitProcessorOption {
+	return rateLimitOptionFunc(func(cfg rateLimitConfig) rateLimitConfig {
+		if perSecond > 0 {
+			cfg.rate = perSecond
+		}
+		if burst > 0 {
+			cfg.burst = burst
+		}
+		return cfg
+	})
+}
+
+// WithSummaryInterval sets the interval at which a [RateLimitProcessor]
+// passes the summaries of suppressed log records.
+//
+// By default, 10s is used. The default value is also used when the provided
+// value is not positive.
+func WithSummaryInterval(d time.Duration) RateLimitProcessorOption {
+	return rateLimitOptionFunc(func(cfg rateLimitConfig) rateLimitConfig {
+		if d > 0 {
+			cfg.interval = d
+		}
+		return cfg
+	})
+}
+
+// WithRateLimitKey sets the function returning the key of a log record used
+// by a [RateLimitProcessor]. Log records with the same key, instrumentation
+// scope name, and severity are identical, and share a rate limit. The key
+// should not include the variable parts of a message, and should be cheap to
+// compute, as it is called for every log record.
+//
+// Log records for which key returns an empty string are not rate limited.
+//
+// By default, the event name of the log record is used, the first 128 bytes
+// of its body if it is a string, or a hash of its body otherwise. The default
+// value is also used when the provided value is nil.
+func WithRateLimitKey(key func(*Record) string) RateLimitProcessorOption {
+	return rateLimitOptionFunc(func(cfg rateLimitConfig) rateLimitConfig {
+		if key != nil {
+			cfg.key = key
+		}
+		return cfg
+	})
+}
+
+// WithMaxRateLimitKeys sets the maximum number of sets of identical log
+// records a [RateLimitProcessor] tracks. Once the maximum is reached, the log
+// records that are not identical to a tracked set share a rate limit with all
+// the other ones of the same instrumentation scope name and severity. Sets
+// are forgotten once their token bucket is full again.
+//
+// By default, 1024 is used. The default value is also used when the provided
+// value is not positive.
+func WithMaxRateLimitKeys(n int) RateLimitProcessorOption {
+	return rateLimitOptionFunc(func(cfg rateLimitConfig) rateLimitConfig {
+		if n > 0 {
+			cfg.maxKeys = n
+		}
+		return cfg
+	})
+}
@@ -0,0 +1,300 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newRateLimitProcessor(t *testing.T, p Processor, opts ...RateLimitProcessorOption) (*RateLimitProcessor, *clock) {
+	t.Helper()
+
+	// Summaries are only passed on ForceFlush in tests.
+	opts = append(opts, WithSummaryInterval(time.Hour))
+	r := NewRateLimitProcessor(p, opts...)
+	c := &clock{now: time.Unix(1000, 0)}
+	r.now = c.Now
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+	return r, c
+}
+
+func rateRecord(body string, severity log.Severity) *Record {
+	r := &Record{scope: &instrumentation.Scope{Name: "scope"}, attributeCountLimit: -1}
+	r.SetBody(attribute.StringValue(body))
+	r.SetSeverity(severity)
+	r.SetTimestamp(time.Unix(1, 0))
+	return r
+}
+
+func emit(t *testing.T, p Processor, r *Record, n int) {
+	t.Helper()
+	for range n {
+		require.NoError(t, p.OnEmit(t.Context(), r))
+	}
+}
+
+func TestRateLimitProcessor(t *testing.T) {
+	p := newProcessor("wrapped")
+	r, c := newRateLimitProcessor(t, p, WithRateLimit(2, 5))
+
+	emit(t, r, rateRecord("hot", log.SeverityError), 8)
+	assert.Len(t, p.records, 5, "burst")
+
+	// Other keys have their own bucket.
+	emit(t, r, rateRecord("other", log.SeverityError), 1)
+	emit(t, r, rateRecord("hot", log.SeverityWarn), 1)
+	other := rateRecord("hot", log.SeverityError)
+	other.scope = &instrumentation.Scope{Name: "other"}
+	emit(t, r, other, 1)
+	assert.Len(t, p.records, 8)
+
+	c.Advance(time.Second)
+	emit(t, r, rateRecord("hot", log.SeverityError), 4)
+	assert.Len(t, p.records, 10, "rate")
+
+	p.records = nil
+	require.NoError(t, r.ForceFlush(t.Context()))
+	require.Len(t, p.records, 1)
+	summary := p.records[0]
+	assert.Equal(t, "hot", summary.Body().AsString())
+	assert.Equal(t, log.SeverityError, summary.Severity())
+	assert.Equal(t, time.Unix(1, 0), summary.Timestamp())
+	assert.Equal(t, c.Now(), summary.ObservedTimestamp())
+	var count attribute.Value
+	summary.WalkAttributes(func(kv attribute.KeyValue) bool {
+		if kv.Key == SuppressedCountKey {
+			count = kv.Value
+		}
+		return true
+	})
+	assert.Equal(t, attribute.Int64Value(5), count)
+
+	p.records = nil
+	require.NoError(t, r.ForceFlush(t.Context()))
+	assert.Empty(t, p.records, "summaries are passed once")
+}
+
+func TestRateLimitProcessorForgetsFullBuckets(t *testing.T) {
+	p := newProcessor("wrapped")
+	r, c := newRateLimitProcessor(t, p, WithRateLimit(1, 2), WithMaxRateLimitKeys(1))
+
+	emit(t, r, rateRecord("a", log.SeverityInfo), 3)
+	c.Advance(time.Second)
+	require.NoError(t, r.ForceFlush(t.Context()))
+	assert.Len(t, r.buckets, 1, "bucket is not full")
+
+	c.Advance(time.Second)
+	require.NoError(t, r.ForceFlush(t.Context()))
+	assert.Empty(t, r.buckets, "full bucket is forgotten")
+
+	p.records = nil
+	emit(t, r, rateRecord("b", log.SeverityInfo), 3)
+	assert.Len(t, p.records, 2, "b is now tracked")
+	assert.Contains(t, r.buckets, rateKey{key: "b", scope: "scope", severity: log.SeverityInfo})
+}
+
+func TestRateLimitProcessorOverflow(t *testing.T) {
+	p := newProcessor("wrapped")
+	r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 2), WithMaxRateLimitKeys(1))
+
+	emit(t, r, rateRecord("a", log.SeverityInfo), 3)
+	assert.Len(t, p.records, 2)
+
+	// Once the maximum is reached, other keys share a bucket per scope and
+	// severity.
+	p.records = nil
+	emit(t, r, rateRecord("b", log.SeverityInfo), 1)
+	emit(t, r, rateRecord("c", log.SeverityInfo), 2)
+	assert.Len(t, p.records, 2, "overflow keys are limited together")
+
+	emit(t, r, rateRecord("d", log.SeverityWarn), 2)
+	assert.Len(t, p.records, 4, "overflow buckets per severity")
+
+	p.records = nil
+	require.NoError(t, r.ForceFlush(t.Context()))
+	assert.Len(t, p.records, 2, "summaries of a and the overflow")
+}
+
+func TestRateLimitProcessorKey(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		p := newProcessor("wrapped")
+		r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 2))
+
+		prefix := strings.Repeat("x", maxRateKeyBodyLen)
+		for i := range 4 {
+			emit(t, r, rateRecord(prefix+strconv.Itoa(i), log.SeverityError), 1)
+		}
+		assert.Len(t, p.records, 2, "only the beginning of the body is the key")
+
+		event := rateRecord("a", log.SeverityError)
+		event.SetEventName("event")
+		emit(t, r, event, 1)
+		event = rateRecord("b", log.SeverityError)
+		event.SetEventName("event")
+		emit(t, r, event, 2)
+		assert.Len(t, p.records, 4, "event name is the key")
+	})
+
+	t.Run("MapBody", func(t *testing.T) {
+		p := newProcessor("wrapped")
+		r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 2))
+
+		mapRecord := func(v string) *Record {
+			rec := rateRecord("", log.SeverityError)
+			rec.SetBody(attribute.MapValue(attribute.String("k", v)))
+			return rec
+		}
+		emit(t, r, mapRecord("a"), 3)
+		assert.Len(t, p.records, 2, "identical map bodies share a bucket")
+		emit(t, r, mapRecord("b"), 2)
+		assert.Len(t, p.records, 4, "different map bodies do not share a bucket")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		p := newProcessor("wrapped")
+		r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 2))
+
+		rec := rateRecord("", log.SeverityError)
+		rec.SetBody(attribute.Value{})
+		emit(t, r, rec, 5)
+		assert.Len(t, p.records, 5, "log records without a key are not limited")
+		assert.Empty(t, r.buckets)
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		p := newProcessor("wrapped")
+		r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 2), WithRateLimitKey(func(r *Record) string {
+			return strings.SplitN(r.Body().AsString(), " ", 2)[0]
+		}))
+		emit(t, r, rateRecord("failed 1", log.SeverityError), 1)
+		emit(t, r, rateRecord("failed 2", log.SeverityError), 1)
+		emit(t, r, rateRecord("failed 3", log.SeverityError), 1)
+		emit(t, r, rateRecord("done 1", log.SeverityError), 1)
+		assert.Len(t, p.records, 3)
+	})
+}
+
+func TestRateLimitProcessorPeriodicSummary(t *testing.T) {
+	p := newProcessor("wrapped")
+	var (
+		mu        sync.Mutex
+		summaries int
+	)
+	p.onEmitFunc = func(_ context.Context, r *Record) error {
+		r.WalkAttributes(func(kv attribute.KeyValue) bool {
+			if kv.Key == SuppressedCountKey {
+				mu.Lock()
+				summaries++
+				mu.Unlock()
+			}
+			return true
+		})
+		return nil
+	}
+	r := NewRateLimitProcessor(p, WithRateLimit(1, 1), WithSummaryInterval(time.Millisecond))
+	t.Cleanup(func() { require.NoError(t, r.Shutdown(context.Background())) })
+
+	emit(t, r, rateRecord("hot", log.SeverityInfo), 100)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return summaries > 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestRateLimitProcessorShutdown(t *testing.T) {
+	p := newProcessor("wrapped")
+	r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 1))
+	emit(t, r, rateRecord("hot", log.SeverityInfo), 3)
+
+	require.NoError(t, r.Shutdown(t.Context()))
+	assert.Len(t, p.records, 2, "summary is passed on shutdown")
+	assert.Equal(t, 1, p.shutdownCalls)
+
+	require.NoError(t, r.Shutdown(t.Context()))
+	assert.Len(t, p.records, 2)
+	assert.Equal(t, 1, p.shutdownCalls)
+}
+
+func TestRateLimitProcessorWrapped(t *testing.T) {
+	p := newProcessor("wrapped")
+	p.Err = errors.New("processor error")
+	p.enabledFunc = func(context.Context, EnabledParameters) bool { return false }
+	r, _ := newRateLimitProcessor(t, p, WithRateLimit(1, 1))
+
+	assert.False(t, r.Enabled(t.Context(), EnabledParameters{}))
+	assert.ErrorIs(t, r.OnEmit(t.Context(), rateRecord("hot", log.SeverityInfo)), p.Err)
+	require.NoError(t, r.OnEmit(t.Context(), rateRecord("hot", log.SeverityInfo)))
+	assert.ErrorIs(t, r.ForceFlush(t.Context()), p.Err, "summary error")
+	assert.Equal(t, 1, p.forceFlushCalls)
+	assert.ErrorIs(t, r.Shutdown(t.Context()), p.Err)
+	assert.Equal(t, 1, p.shutdownCalls)
+}
+
+func TestRateLimitProcessorOptions(t *testing.T) {
+	r := NewRateLimitProcessor(
+		newProcessor("wrapped"),
+		WithRateLimit(-1, 0),
+		WithSummaryInterval(-time.Second),
+		WithMaxRateLimitKeys(0),
+		WithRateLimitKey(nil),
+	)
+	t.Cleanup(func() { require.NoError(t, r.Shutdown(context.Background())) })
+	assert.InDelta(t, float64(dfltRateLimit), r.cfg.rate, 0)
+	assert.Equal(t, dfltRateBurst, r.cfg.burst)
+	assert.Equal(t, dfltSummaryInterval, r.cfg.interval)
+	assert.Equal(t, dfltMaxRateKeys, r.cfg.maxKeys)
+	assert.NotNil(t, r.cfg.key)
+}
+
+func TestRateLimitProcessorConcurrentSafe(t *testing.T) {
+	const goRoutineN = 10
+
+	var wg sync.WaitGroup
+	wg.Add(goRoutineN)
+
+	ctx := context.Background()
+	r := NewRateLimitProcessor(NewSimpleProcessor(nil), WithSummaryInterval(time.Millisecond))
+	for range goRoutineN {
+		go func() {
+			defer wg.Done()
+
+			_ = r.OnEmit(ctx, rateRecord("hot", log.SeverityInfo))
+			_ = r.ForceFlush(ctx)
+		}()
+	}
+
+	wg.Wait()
+	require.NoError(t, r.Shutdown(ctx))
+}
@@ -35,6 +35,10 @@ var logKeyValuePairDropped = sync.OnceFunc(func() {
 	global.Warn("key duplication: dropping key-value pair")
 })
 
+var logValueTruncated = sync.OnceFunc(func() {
+	global.Warn("limit reached: truncating log Record body or attribute values")
+})
+
 var logInvalidAttribute = sync.OnceFunc(func() {
 	global.Warn("invalid attribute: dropping attribute with empty key")
 })
@@ -122,6 +126,30 @@ type Record struct {
 	attributeValueLengthLimit int
 	attributeCountLimit       int
 
+	// bodyLengthLimit and valueDepthLimit are the body length and value depth
+	// limits. No limit is applied if they are not positive.
+	bodyLengthLimit int
+	valueDepthLimit int
+
+	// truncatedAttr specifies whether the TruncatedKey attribute is added
+	// when the body or attribute values are truncated.
+	truncatedAttr bool
+	// hasTruncatedAttr is true if the TruncatedKey attribute was added.
+	hasTruncatedAttr bool
+	// deferTruncated is true while the attributes of the Record are being
+	// set. The TruncatedKey attribute is then only added once all of them
+	// are, so that it does not take the place of one of them if the attribute
+	// count limit is reached.
+	deferTruncated bool
+	// truncatedDeferred is true if the TruncatedKey attribute is to be added
+	// once deferTruncated is unset.
+	truncatedDeferred bool
+	// bodyTruncated is true if the body was truncated.
+	bodyTruncated bool
+	// attrTruncated is true if attribute values were truncated and not
+	// handled yet.
+	attrTruncated bool
+
 	// allowDupKeys specifies whether duplicate keys are allowed in key-value
 	// collections.
 	allowDupKeys bool
@@ -198,11 +226,16 @@ func (r *Record) Body() attribute.Value {
 }
 
 // SetBody sets the body of the log record.
+//
+// The body is truncated if it exceeds the limits set with
+// [WithBodyLengthLimit] and [WithValueDepthLimit].
 func (r *Record) SetBody(v attribute.Value) {
 	if !r.allowDupKeys {
-		r.body, _ = attrnorm.Value(v)
-	} else {
-		r.body = v
+		v, _ = attrnorm.Value(v)
+	}
+	r.body, r.bodyTruncated = r.truncateBody(v)
+	if r.bodyTruncated {
+		r.setTruncated()
 	}
 }
 
@@ -348,6 +381,11 @@ func (r *Record) addAttrs(attrs []attribute.KeyValue) {
 	for i, a := range r.back[j:] {
 		r.back[i+j] = r.applyAttrLimitsAndDedup(a)
 	}
+
+	if r.attrTruncated {
+		r.attrTruncated = false
+		r.setTruncated()
+	}
 }
 
 // SetAttributes sets (and overrides) attributes on the log record.
@@ -356,6 +394,7 @@ func (r *Record) SetAttributes(attrs ...attribute.KeyValue) {
 	attrs = filterInvalid(attrs)
 	var drop int
 	r.dropped = 0
+	r.hasTruncatedAttr = false
 	if !r.allowDupKeys {
 		attrs, drop = dedup(attrs)
 		if drop > 0 {
@@ -378,6 +417,11 @@ func (r *Record) SetAttributes(attrs ...attribute.KeyValue) {
 	for i, a := range r.back {
 		r.back[i] = r.applyAttrLimitsAndDedup(a)
 	}
+
+	if r.attrTruncated || r.bodyTruncated {
+		r.attrTruncated = false
+		r.setTruncated()
+	}
 }
 
 // filterInvalid returns attrs without invalid attributes. The original slice is
@@ -521,6 +565,10 @@ func (r *Record) applyAttrLimitsAndDedup(attr attribute.KeyValue) attribute.KeyV
 			logKeyValuePairDropped()
 		}
 	}
-	attr.Value = attrnorm.TruncateValue(r.attributeValueLengthLimit, attr.Value)
+	var truncated bool
+	attr.Value, truncated = r.truncateValue(attr.Value)
+	if truncated {
+		r.attrTruncated = true
+	}
 	return attr
 }
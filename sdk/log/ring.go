@@ -17,6 +17,10 @@ package log
 type ring struct {
 	next, prev *ring
 	Value      Record
+
+	// levelNext is the next element holding a record of the same severity
+	// level. It is used by queue.
+	levelNext *ring
 }
 
 func (r *ring) init() *ring {
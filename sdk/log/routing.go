@@ -0,0 +1,329 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// This is a compile-time check that RoutingProcessor implements Processor.
+var _ Processor = (*RoutingProcessor)(nil)
+
+// RoutingProcessor is a Processor that passes log records to the Processors
+// of the routes they match. Each route has a [RouteMatcher] that selects its
+// log records. A log record is passed to every route it matches, in the order
+// the routes were added. A log record that matches no route is passed to the
+// default route, if any.
+//
+// Use a [SimpleProcessor] or a [BatchProcessor] as the Processor of a route
+// to send its log records to an [Exporter].
+//
+// The Processors of the routes share the log records they are passed, like the
+// Processors registered with a [LoggerProvider]. A Processor should only be
+// used by one route.
+//
+// Use [NewRoutingProcessor] to create a RoutingProcessor.
+type RoutingProcessor struct {
+	routes []route
+	dflt   Processor
+}
+
+type route struct {
+	matcher   RouteMatcher
+	processor Processor
+}
+
+// NewRoutingProcessor returns a new RoutingProcessor with the routes added by
+// opts.
+func NewRoutingProcessor(opts ...RoutingProcessorOption) *RoutingProcessor {
+	var cfg routingConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &RoutingProcessor{routes: cfg.routes, dflt: cfg.dflt}
+}
+
+// Enabled returns true if a route may match a log record with param and its
+// Processor is enabled for it, or if the Processor of the default route is
+// enabled for it. It returns false otherwise.
+//
+// It may return true for log records that are eventually not processed, as
+// the attributes and resource of log records are not known in advance.
+func (p *RoutingProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	for _, r := range p.routes {
+		if r.matcher.Enabled(ctx, param) && r.processor.Enabled(ctx, param) {
+			return true
+		}
+	}
+	return p.dflt != nil && p.dflt.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the Processors of the routes it matches, or to the
+// Processor of the default route if it matches none.
+func (p *RoutingProcessor) OnEmit(ctx context.Context, record *Record) error {
+	var (
+		matched bool
+		errs    []error
+	)
+	for _, r := range p.routes {
+		if !r.matcher.Match(ctx, record) {
+			continue
+		}
+		matched = true
+		if err := r.processor.OnEmit(ctx, record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !matched && p.dflt != nil {
+		if err := p.dflt.OnEmit(ctx, record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Shutdown shuts down the Processors of all routes.
+func (p *RoutingProcessor) Shutdown(ctx context.Context) error {
+	return p.each(func(proc Processor) error { return proc.Shutdown(ctx) })
+}
+
+// ForceFlush flushes the Processors of all routes.
+func (p *RoutingProcessor) ForceFlush(ctx context.Context) error {
+	return p.each(func(proc Processor) error { return proc.ForceFlush(ctx) })
+}
+
+func (p *RoutingProcessor) each(f func(Processor) error) error {
+	var errs []error
+	for _, r := range p.routes {
+		if err := f(r.processor); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if p.dflt != nil {
+		if err := f(p.dflt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RouteMatcher selects the log records of a route of a [RoutingProcessor].
+type RouteMatcher interface {
+	// Enabled reports whether a log record with param may match. It must
+	// return true if this is not known, e.g. because the match depends on the
+	// attributes of the log record.
+	Enabled(ctx context.Context, param EnabledParameters) bool
+
+	// Match reports whether record matches.
+	Match(ctx context.Context, record *Record) bool
+}
+
+// MatchScope returns a [RouteMatcher] that matches log records emitted with
+// one of the instrumentation scope names.
+func MatchScope(names ...string) RouteMatcher {
+	return scopeMatcher(slices.Clone(names))
+}
+
+type scopeMatcher []string
+
+func (m scopeMatcher) Enabled(_ context.Context, param EnabledParameters) bool {
+	return slices.Contains(m, param.InstrumentationScope.Name)
+}
+
+func (m scopeMatcher) Match(_ context.Context, record *Record) bool {
+	return slices.Contains(m, record.InstrumentationScope().Name)
+}
+
+// MatchMinSeverity returns a [RouteMatcher] that matches log records with a
+// severity greater than or equal to minimum.
+//
+// The severity is not known in advance if [EnabledParameters] has an
+// undefined severity, so Enabled returns true in that case.
+func MatchMinSeverity(minimum log.Severity) RouteMatcher {
+	return severityMatcher(minimum)
+}
+
+type severityMatcher log.Severity
+
+func (m severityMatcher) Enabled(_ context.Context, param EnabledParameters) bool {
+	return param.Severity == log.SeverityUndefined || param.Severity >= log.Severity(m)
+}
+
+func (m severityMatcher) Match(_ context.Context, record *Record) bool {
+	return record.Severity() >= log.Severity(m)
+}
+
+// MatchEventName returns a [RouteMatcher] that matches log records with one
+// of the event names.
+//
+// The event name is not known in advance if [EnabledParameters] has an empty
+// event name, so Enabled returns true in that case.
+func MatchEventName(names ...string) RouteMatcher {
+	return eventMatcher(slices.Clone(names))
+}
+
+type eventMatcher []string
+
+func (m eventMatcher) Enabled(_ context.Context, param EnabledParameters) bool {
+	return param.EventName == "" || slices.Contains(m, param.EventName)
+}
+
+func (m eventMatcher) Match(_ context.Context, record *Record) bool {
+	return slices.Contains(m, record.EventName())
+}
+
+// MatchAttribute returns a [RouteMatcher] that matches log records with the
+// attribute kv.
+func MatchAttribute(kv attribute.KeyValue) RouteMatcher {
+	return attrMatcher(kv)
+}
+
+type attrMatcher attribute.KeyValue
+
+func (attrMatcher) Enabled(context.Context, EnabledParameters) bool {
+	return true
+}
+
+func (m attrMatcher) Match(_ context.Context, record *Record) bool {
+	var found bool
+	record.WalkAttributes(func(kv attribute.KeyValue) bool {
+		found = kv == attribute.KeyValue(m)
+		return !found
+	})
+	return found
+}
+
+// MatchResource returns a [RouteMatcher] that matches log records emitted
+// with a resource that has the attribute kv.
+func MatchResource(kv attribute.KeyValue) RouteMatcher {
+	return resourceMatcher(kv)
+}
+
+type resourceMatcher attribute.KeyValue
+
+func (resourceMatcher) Enabled(context.Context, EnabledParameters) bool {
+	return true
+}
+
+func (m resourceMatcher) Match(_ context.Context, record *Record) bool {
+	v, ok := record.Resource().Set().Value(m.Key)
+	return ok && v == m.Value
+}
+
+// MatchFunc returns a [RouteMatcher] that matches the log records for which
+// f returns true.
+func MatchFunc(f func(ctx context.Context, record *Record) bool) RouteMatcher {
+	return funcMatcher(f)
+}
+
+type funcMatcher func(context.Context, *Record) bool
+
+func (funcMatcher) Enabled(context.Context, EnabledParameters) bool {
+	return true
+}
+
+func (m funcMatcher) Match(ctx context.Context, record *Record) bool {
+	return m(ctx, record)
+}
+
+// MatchAll returns a [RouteMatcher] that matches log records matched by all of
+// the matchers.
+func MatchAll(matchers ...RouteMatcher) RouteMatcher {
+	return allMatcher(slices.Clone(matchers))
+}
+
+type allMatcher []RouteMatcher
+
+func (m allMatcher) Enabled(ctx context.Context, param EnabledParameters) bool {
+	for _, matcher := range m {
+		if !matcher.Enabled(ctx, param) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m allMatcher) Match(ctx context.Context, record *Record) bool {
+	for _, matcher := range m {
+		if !matcher.Match(ctx, record) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchAny returns a [RouteMatcher] that matches log records matched by any
+// of the matchers.
+func MatchAny(matchers ...RouteMatcher) RouteMatcher {
+	return anyMatcher(slices.Clone(matchers))
+}
+
+type anyMatcher []RouteMatcher
+
+func (m anyMatcher) Enabled(ctx context.Context, param EnabledParameters) bool {
+	for _, matcher := range m {
+		if matcher.Enabled(ctx, param) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m anyMatcher) Match(ctx context.Context, record *Record) bool {
+	for _, matcher := range m {
+		if matcher.Match(ctx, record) {
+			return true
+		}
+	}
+	return false
+}
+
+type routingConfig struct {
+	routes []route
+	dflt   Processor
+}
+
+// RoutingProcessorOption applies a configuration to a [RoutingProcessor].
+type RoutingProcessorOption interface {
+	apply(routingConfig) routingConfig
+}
+
+type routingOptionFunc func(routingConfig) routingConfig
+
+func (fn routingOptionFunc) apply(c routingConfig) routingConfig {
+	return fn(c)
+}
+
+// WithRoute adds a route to a [RoutingProcessor] that passes the log records
+// matched by matcher to processor. If matcher is nil, all log records are
+// matched.
+//
+// This option can be passed multiple times to add multiple routes. The routes
+// are matched in the order they are added.
+func WithRoute(matcher RouteMatcher, processor Processor) RoutingProcessorOption {
+	return routingOptionFunc(func(cfg routingConfig) routingConfig {
+		if matcher == nil {
+			matcher = allMatcher(nil)
+		}
+		cfg.routes = append(cfg.routes, route{matcher: matcher, processor: processor})
+		return cfg
+	})
+}
+
+// WithDefaultRoute sets the Processor of a [RoutingProcessor] that is passed
+// the log records that match no route.
+//
+// By default, there is no default route and the log records that match no
+// route are dropped.
+func WithDefaultRoute(processor Processor) RoutingProcessorOption {
+	return routingOptionFunc(func(cfg routingConfig) routingConfig {
+		cfg.dflt = processor
+		return cfg
+	})
+}
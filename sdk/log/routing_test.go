@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func routeRecord(scope string, severity log.Severity, eventName string, attrs ...attribute.KeyValue) *Record {
+	r := &Record{
+		scope:                     &instrumentation.Scope{Name: scope},
+		resource:                  resource.NewSchemaless(attribute.String("service.name", "svc")),
+		attributeCountLimit:       -1,
+		attributeValueLengthLimit: -1,
+	}
+	r.SetSeverity(severity)
+	r.SetEventName(eventName)
+	r.AddAttributes(attrs...)
+	return r
+}
+
+func TestRoutingProcessorOnEmit(t *testing.T) {
+	audit, errs, dflt := newProcessor("audit"), newProcessor("errors"), newProcessor("default")
+	p := NewRoutingProcessor(
+		WithRoute(MatchScope("audit"), audit),
+		WithRoute(MatchMinSeverity(log.SeverityError), errs),
+		WithDefaultRoute(dflt),
+	)
+	ctx := t.Context()
+
+	require.NoError(t, p.OnEmit(ctx, routeRecord("audit", log.SeverityInfo, "")))
+	require.NoError(t, p.OnEmit(ctx, routeRecord("audit", log.SeverityError, "")))
+	require.NoError(t, p.OnEmit(ctx, routeRecord("app", log.SeverityFatal, "")))
+	require.NoError(t, p.OnEmit(ctx, routeRecord("app", log.SeverityDebug, "")))
+
+	assert.Len(t, audit.records, 2)
+	require.Len(t, errs.records, 2, "fan-out to all matching routes")
+	assert.Equal(t, "audit", errs.records[0].InstrumentationScope().Name)
+	assert.Equal(t, "app", errs.records[1].InstrumentationScope().Name)
+	require.Len(t, dflt.records, 1)
+	assert.Equal(t, log.SeverityDebug, dflt.records[0].Severity())
+}
+
+func TestRoutingProcessorNoDefault(t *testing.T) {
+	route := newProcessor("route")
+	p := NewRoutingProcessor(WithRoute(MatchEventName("login"), route))
+
+	require.NoError(t, p.OnEmit(t.Context(), routeRecord("app", log.SeverityInfo, "logout")))
+	require.NoError(t, p.OnEmit(t.Context(), routeRecord("app", log.SeverityInfo, "login")))
+	require.Len(t, route.records, 1)
+	assert.Equal(t, "login", route.records[0].EventName())
+}
+
+func TestRoutingProcessorEnabled(t *testing.T) {
+	audit, dflt := newProcessor("audit"), newProcessor("default")
+	dflt.enabledFunc = func(_ context.Context, param EnabledParameters) bool {
+		return param.Severity >= log.SeverityWarn
+	}
+	p := NewRoutingProcessor(
+		WithRoute(MatchScope("audit"), audit),
+		WithDefaultRoute(dflt),
+	)
+	param := func(scope string, severity log.Severity) EnabledParameters {
+		return EnabledParameters{
+			InstrumentationScope: instrumentation.Scope{Name: scope},
+			Severity:             severity,
+		}
+	}
+
+	ctx := t.Context()
+	assert.True(t, p.Enabled(ctx, param("audit", log.SeverityDebug)))
+	assert.False(t, p.Enabled(ctx, param("app", log.SeverityDebug)))
+	assert.True(t, p.Enabled(ctx, param("app", log.SeverityWarn)))
+
+	audit.enabledFunc = func(context.Context, EnabledParameters) bool { return false }
+	assert.False(t, p.Enabled(ctx, param("audit", log.SeverityDebug)), "disabled route processor")
+
+	assert.False(t, NewRoutingProcessor().Enabled(ctx, param("app", log.SeverityFatal)), "no routes")
+}
+
+func TestRoutingProcessorErrors(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	a, b := newProcessor("a"), newProcessor("b")
+	a.Err, b.Err = errA, errB
+	p := NewRoutingProcessor(WithRoute(nil, a), WithDefaultRoute(b))
+
+	err := p.OnEmit(t.Context(), routeRecord("app", log.SeverityInfo, ""))
+	assert.ErrorIs(t, err, errA)
+	assert.NotErrorIs(t, err, errB, "default route is not used when a route matches")
+
+	err = p.ForceFlush(t.Context())
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+	assert.Equal(t, 1, a.forceFlushCalls)
+	assert.Equal(t, 1, b.forceFlushCalls)
+
+	err = p.Shutdown(t.Context())
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+	assert.Equal(t, 1, a.shutdownCalls)
+	assert.Equal(t, 1, b.shutdownCalls)
+}
+
+func TestRouteMatchers(t *testing.T) {
+	ctx := t.Context()
+	r := routeRecord(
+		"scope",
+		log.SeverityWarn,
+		"event",
+		attribute.String("k", "v"),
+		attribute.StringSlice("s", []string{"a", "b"}),
+	)
+
+	tests := []struct {
+		name    string
+		matcher RouteMatcher
+		param   EnabledParameters
+		enabled bool
+		match   bool
+	}{
+		{
+			name:    "Scope",
+			matcher: MatchScope("other", "scope"),
+			param:   EnabledParameters{InstrumentationScope: instrumentation.Scope{Name: "scope"}},
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "ScopeMismatch",
+			matcher: MatchScope("other"),
+			param:   EnabledParameters{InstrumentationScope: instrumentation.Scope{Name: "scope"}},
+		},
+		{
+			name:    "MinSeverity",
+			matcher: MatchMinSeverity(log.SeverityWarn),
+			param:   EnabledParameters{Severity: log.SeverityWarn},
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "MinSeverityMismatch",
+			matcher: MatchMinSeverity(log.SeverityError),
+			param:   EnabledParameters{Severity: log.SeverityWarn},
+		},
+		{
+			name:    "MinSeverityUndefined",
+			matcher: MatchMinSeverity(log.SeverityError),
+			enabled: true,
+		},
+		{
+			name:    "EventName",
+			matcher: MatchEventName("event"),
+			param:   EnabledParameters{EventName: "event"},
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "EventNameMismatch",
+			matcher: MatchEventName("other"),
+			param:   EnabledParameters{EventName: "event"},
+		},
+		{
+			name:    "EventNameUnknown",
+			matcher: MatchEventName("other"),
+			enabled: true,
+		},
+		{
+			name:    "Attribute",
+			matcher: MatchAttribute(attribute.String("k", "v")),
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "AttributeSlice",
+			matcher: MatchAttribute(attribute.StringSlice("s", []string{"a", "b"})),
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "AttributeMismatch",
+			matcher: MatchAttribute(attribute.String("k", "other")),
+			enabled: true,
+		},
+		{
+			name:    "Resource",
+			matcher: MatchResource(attribute.String("service.name", "svc")),
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "ResourceMismatch",
+			matcher: MatchResource(attribute.String("service.name", "other")),
+			enabled: true,
+		},
+		{
+			name: "Func",
+			matcher: MatchFunc(func(_ context.Context, r *Record) bool {
+				return r.Severity() == log.SeverityWarn
+			}),
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "All",
+			matcher: MatchAll(MatchScope("scope"), MatchAttribute(attribute.String("k", "v"))),
+			param:   EnabledParameters{InstrumentationScope: instrumentation.Scope{Name: "scope"}},
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "AllMismatch",
+			matcher: MatchAll(MatchScope("scope"), MatchEventName("other")),
+			param: EnabledParameters{
+				InstrumentationScope: instrumentation.Scope{Name: "scope"},
+				EventName:            "event",
+			},
+		},
+		{
+			name:    "AllEmpty",
+			matcher: MatchAll(),
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "Any",
+			matcher: MatchAny(MatchScope("other"), MatchEventName("event")),
+			param:   EnabledParameters{EventName: "event"},
+			enabled: true,
+			match:   true,
+		},
+		{
+			name:    "AnyMismatch",
+			matcher: MatchAny(MatchScope("other"), MatchMinSeverity(log.SeverityFatal)),
+			param:   EnabledParameters{Severity: log.SeverityWarn},
+		},
+		{
+			name:    "AnyEmpty",
+			matcher: MatchAny(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.enabled, tt.matcher.Enabled(ctx, tt.param), "Enabled")
+			assert.Equal(t, tt.match, tt.matcher.Match(ctx, r), "Match")
+		})
+	}
+}
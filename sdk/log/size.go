@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log/internal/protosize"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// recordSize returns an estimate of the size of the encoding of r as an OTLP
+// LogRecord message within a ScopeLogs message.
+func recordSize(r *Record) int {
+	n := 2*protosize.Fixed64Size + // Timestamp and ObservedTimestamp.
+		protosize.VarintFieldSize + // Severity.
+		protosize.Len(len(r.SeverityText())) +
+		protosize.Len(protosize.Value(r.Body())) +
+		protosize.VarintFieldSize + // Dropped attributes count.
+		protosize.Fixed32Size + // Flags.
+		protosize.Len(16) + // TraceID.
+		protosize.Len(8) + // SpanID.
+		protosize.Len(len(r.EventName()))
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		n += protosize.Len(protosize.KeyValue(kv))
+		return true
+	})
+	return protosize.Len(n)
+}
+
+// resourceSize returns an estimate of the size of the encoding of res as an
+// OTLP ResourceLogs message without its ScopeLogs.
+func resourceSize(res *resource.Resource) int {
+	n := protosize.VarintFieldSize + protosize.Len(len(res.SchemaURL())) + protosize.Attributes(res.Attributes())
+	return protosize.Len(protosize.Len(n))
+}
+
+// scopeSize returns an estimate of the size of the encoding of s as an OTLP
+// ScopeLogs message without its LogRecords.
+func scopeSize(s *instrumentation.Scope) int {
+	if s == nil {
+		return 0
+	}
+	n := protosize.Len(len(s.Name)) + protosize.Len(len(s.Version)) + protosize.VarintFieldSize +
+		protosize.Attributes(s.Attributes.ToSlice())
+	return protosize.Len(protosize.Len(n)) + protosize.Len(len(s.SchemaURL))
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestRecordSize(t *testing.T) {
+	var r Record
+	r.attributeCountLimit = -1
+	r.attributeValueLengthLimit = -1
+	base := recordSize(&r)
+	assert.Positive(t, base)
+
+	r.SetBody(attribute.StringValue(strings.Repeat("x", 1000)))
+	withBody := recordSize(&r)
+	assert.GreaterOrEqual(t, withBody, base+1000)
+
+	r.AddAttributes(attribute.String("key", strings.Repeat("v", 100)))
+	assert.GreaterOrEqual(t, recordSize(&r), withBody+len("key")+100)
+}
+
+func TestResourceScopeSize(t *testing.T) {
+	res := resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc"))
+	assert.GreaterOrEqual(t, resourceSize(res), len("https://schema")+len("service.name")+len("svc"))
+	assert.Positive(t, resourceSize(nil))
+
+	scope := &instrumentation.Scope{
+		Name:       "name",
+		Version:    "v1",
+		SchemaURL:  "https://schema",
+		Attributes: attribute.NewSet(attribute.String("k", "v")),
+	}
+	assert.GreaterOrEqual(t, scopeSize(scope), len("name")+len("v1")+len("https://schema")+len("kv"))
+	assert.Zero(t, scopeSize(nil))
+}
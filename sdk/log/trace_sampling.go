@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"encoding/binary"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// This is a compile-time check that TraceSamplingProcessor implements
+// Processor.
+var _ Processor = (*TraceSamplingProcessor)(nil)
+
+// TraceSamplingProcessor is a Processor that samples log records based on the
+// trace they are emitted in, so that the logs kept follow the sampling
+// decisions of traces.
+//
+// A log record emitted in a trace is kept if the trace is sampled, as
+// reported by the sampled flag of the span context in the context passed to
+// the Processor. If [WithTraceIDRatio] is used, it is kept if its trace ID is
+// part of the ratio instead, which matches the decisions of a
+// [go.opentelemetry.io/otel/sdk/trace.TraceIDRatioBased] sampler with the
+// same fraction. If the context has no valid span context, the trace of the
+// log record is used.
+//
+// Log records with a severity of at least the severity floor are always
+// kept, see [WithSeverityFloor]. Log records emitted outside a trace are kept
+// unless [WithKeepUntraced] is used with false.
+//
+// Use [NewTraceSamplingProcessor] to create a TraceSamplingProcessor.
+type TraceSamplingProcessor struct {
+	processor Processor
+	cfg       traceSamplingConfig
+}
+
+// NewTraceSamplingProcessor returns a new TraceSamplingProcessor that passes
+// the sampled log records to processor.
+func NewTraceSamplingProcessor(processor Processor, opts ...TraceSamplingProcessorOption) *TraceSamplingProcessor {
+	cfg := traceSamplingConfig{floor: dfltSeverityFloor, keepUntraced: true}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &TraceSamplingProcessor{processor: processor, cfg: cfg}
+}
+
+// keep reports whether a log record with severity emitted in the trace of sc
+// is kept.
+func (p *TraceSamplingProcessor) keep(severity log.Severity, sc trace.SpanContext) bool {
+	if severity >= p.cfg.floor {
+		return true
+	}
+	if !sc.HasTraceID() {
+		return p.cfg.keepUntraced
+	}
+	if p.cfg.useRatio {
+		tid := sc.TraceID()
+		return binary.BigEndian.Uint64(tid[8:16])>>1 < p.cfg.traceIDBound
+	}
+	return sc.IsSampled()
+}
+
+// Enabled returns false if a log record with param emitted with ctx is
+// dropped. Otherwise, it returns the result of Enabled of the wrapped
+// Processor.
+//
+// If the severity of param is undefined, it cannot be compared to the
+// severity floor, and the log record is assumed to be kept.
+func (p *TraceSamplingProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if param.Severity != log.SeverityUndefined && !p.keep(param.Severity, trace.SpanContextFromContext(ctx)) {
+		return false
+	}
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the wrapped Processor if it is kept.
+func (p *TraceSamplingProcessor) OnEmit(ctx context.Context, record *Record) error {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		sc = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    record.TraceID(),
+			SpanID:     record.SpanID(),
+			TraceFlags: record.TraceFlags(),
+		})
+	}
+	if !p.keep(record.Severity(), sc) {
+		return nil
+	}
+	return p.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped Processor.
+func (p *TraceSamplingProcessor) Shutdown(ctx context.Context) error {
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped Processor.
+func (p *TraceSamplingProcessor) ForceFlush(ctx context.Context) error {
+	return p.processor.ForceFlush(ctx)
+}
+
+const dfltSeverityFloor = log.SeverityWarn
+
+type traceSamplingConfig struct {
+	floor        log.Severity
+	keepUntraced bool
+
+	useRatio bool
+	// traceIDBound is the exclusive upper bound of the 63 lowest bits of the
+	// trace IDs that are kept.
+	traceIDBound uint64
+}
+
+// TraceSamplingProcessorOption applies a configuration to a
+// [TraceSamplingProcessor].
+type TraceSamplingProcessorOption interface {
+	apply(traceSamplingConfig) traceSamplingConfig
+}
+
+type traceSamplingOptionFunc func(traceSamplingConfig) traceSamplingConfig
+
+func (fn traceSamplingOptionFunc) apply(c traceSamplingConfig) traceSamplingConfig {
+	return fn(c)
+}
+
+// WithSeverityFloor sets the minimum severity of the log records that a
+// [TraceSamplingProcessor] always keeps, whether their trace is sampled or
+// not. Use [log.SeverityUndefined] to keep all log records, or a severity
+// above [log.SeverityFatal4] to sample all of them.
+//
+// By default, log records with a severity of at least [log.SeverityWarn] are
+// always kept.
+func WithSeverityFloor(floor log.Severity) TraceSamplingProcessorOption {
+	return traceSamplingOptionFunc(func(cfg traceSamplingConfig) traceSamplingConfig {
+		cfg.floor = floor
+		return cfg
+	})
+}
+
+// WithTraceIDRatio makes a [TraceSamplingProcessor] keep the log records
+// emitted in the fraction of traces whose trace ID matches the one kept by a
+// [go.opentelemetry.io/otel/sdk/trace.TraceIDRatioBased] sampler with the
+// same fraction. The sampled flag of traces is then ignored. Fractions
+// greater than or equal to 1 keep all log records emitted in traces, and
+// fractions less than or equal to 0 keep none.
+//
+// By default, the log records of the traces with the sampled flag are kept.
+func WithTraceIDRatio(fraction float64) TraceSamplingProcessorOption {
+	return traceSamplingOptionFunc(func(cfg traceSamplingConfig) traceSamplingConfig {
+		cfg.useRatio = true
+		switch {
+		case fraction >= 1:
+			cfg.traceIDBound = 1 << 63
+		case fraction <= 0:
+			cfg.traceIDBound = 0
+		default:
+			cfg.traceIDBound = uint64(fraction * (1 << 63))
+		}
+		return cfg
+	})
+}
+
+// WithKeepUntraced sets whether a [TraceSamplingProcessor] keeps the log
+// records with a severity below the severity floor that are not emitted in a
+// trace.
+//
+// By default, they are kept.
+func WithKeepUntraced(keep bool) TraceSamplingProcessorOption {
+	return traceSamplingOptionFunc(func(cfg traceSamplingConfig) traceSamplingConfig {
+		cfg.keepUntraced = keep
+		return cfg
+	})
+}
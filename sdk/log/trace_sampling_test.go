@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanContext(tid trace.TraceID, sampled bool) trace.SpanContext {
+	var flags trace.TraceFlags
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     trace.SpanID{1},
+		TraceFlags: flags.WithSampled(sampled),
+	})
+}
+
+func TestTraceSamplingProcessor(t *testing.T) {
+	sampled := trace.ContextWithSpanContext(context.Background(), spanContext(trace.TraceID{1}, true))
+	notSampled := trace.ContextWithSpanContext(context.Background(), spanContext(trace.TraceID{2}, false))
+	untraced := context.Background()
+
+	tests := []struct {
+		name     string
+		opts     []TraceSamplingProcessorOption
+		ctx      context.Context
+		severity log.Severity
+		want     bool
+	}{
+		{name: "Sampled", ctx: sampled, severity: log.SeverityDebug, want: true},
+		{name: "NotSampled", ctx: notSampled, severity: log.SeverityInfo4},
+		{name: "NotSampledFloor", ctx: notSampled, severity: log.SeverityWarn, want: true},
+		{name: "Untraced", ctx: untraced, severity: log.SeverityDebug, want: true},
+		{
+			name:     "UntracedDropped",
+			opts:     []TraceSamplingProcessorOption{WithKeepUntraced(false)},
+			ctx:      untraced,
+			severity: log.SeverityDebug,
+		},
+		{
+			name:     "UntracedDroppedFloor",
+			opts:     []TraceSamplingProcessorOption{WithKeepUntraced(false)},
+			ctx:      untraced,
+			severity: log.SeverityError,
+			want:     true,
+		},
+		{
+			name:     "LoweredFloor",
+			opts:     []TraceSamplingProcessorOption{WithSeverityFloor(log.SeverityInfo)},
+			ctx:      notSampled,
+			severity: log.SeverityInfo,
+			want:     true,
+		},
+		{
+			name:     "RaisedFloor",
+			opts:     []TraceSamplingProcessorOption{WithSeverityFloor(log.SeverityFatal)},
+			ctx:      notSampled,
+			severity: log.SeverityError4,
+		},
+		{
+			name:     "RatioOne",
+			opts:     []TraceSamplingProcessorOption{WithTraceIDRatio(1)},
+			ctx:      notSampled,
+			severity: log.SeverityDebug,
+			want:     true,
+		},
+		{
+			name:     "RatioZero",
+			opts:     []TraceSamplingProcessorOption{WithTraceIDRatio(0)},
+			ctx:      sampled,
+			severity: log.SeverityDebug,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newProcessor("wrapped")
+			s := NewTraceSamplingProcessor(p, tt.opts...)
+
+			got := s.Enabled(tt.ctx, EnabledParameters{Severity: tt.severity})
+			assert.Equal(t, tt.want, got, "Enabled")
+
+			r := new(Record)
+			r.SetSeverity(tt.severity)
+			require.NoError(t, s.OnEmit(tt.ctx, r))
+			assert.Equal(t, tt.want, len(p.records) == 1, "OnEmit")
+		})
+	}
+}
+
+func TestTraceSamplingProcessorUndefinedSeverity(t *testing.T) {
+	p := newProcessor("wrapped")
+	s := NewTraceSamplingProcessor(p)
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext(trace.TraceID{1}, false))
+
+	assert.True(t, s.Enabled(ctx, EnabledParameters{}), "severity is unknown")
+	require.NoError(t, s.OnEmit(ctx, new(Record)))
+	assert.Empty(t, p.records, "undefined severity is below the floor")
+}
+
+func TestTraceSamplingProcessorRecordTrace(t *testing.T) {
+	p := newProcessor("wrapped")
+	s := NewTraceSamplingProcessor(p)
+
+	r := new(Record)
+	r.SetSeverity(log.SeverityDebug)
+	r.SetTraceID(trace.TraceID{1})
+	r.SetSpanID(trace.SpanID{1})
+	require.NoError(t, s.OnEmit(context.Background(), r))
+	assert.Empty(t, p.records, "record trace is not sampled")
+
+	r.SetTraceFlags(trace.FlagsSampled)
+	require.NoError(t, s.OnEmit(context.Background(), r))
+	assert.Len(t, p.records, 1, "record trace is sampled")
+
+	// The context has precedence over the record.
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext(trace.TraceID{2}, false))
+	require.NoError(t, s.OnEmit(ctx, r))
+	assert.Len(t, p.records, 1)
+}
+
+func TestTraceSamplingProcessorRatio(t *testing.T) {
+	const fraction = 0.25
+	sampler := sdktrace.TraceIDRatioBased(fraction)
+	s := NewTraceSamplingProcessor(newProcessor("wrapped"), WithTraceIDRatio(fraction))
+
+	var kept int
+	for i := range uint64(1000) {
+		var tid trace.TraceID
+		// Spread the trace IDs over the whole range.
+		binary.BigEndian.PutUint64(tid[8:], i*0x9e3779b97f4a7c15)
+
+		want := sampler.ShouldSample(sdktrace.SamplingParameters{TraceID: tid}).Decision == sdktrace.RecordAndSample
+		ctx := trace.ContextWithSpanContext(context.Background(), spanContext(tid, !want))
+		got := s.Enabled(ctx, EnabledParameters{Severity: log.SeverityDebug})
+		assert.Equal(t, want, got, "trace ID %s", tid)
+		if got {
+			kept++
+		}
+	}
+	assert.InDelta(t, 250, kept, 100)
+}
+
+func TestTraceSamplingProcessorWrapped(t *testing.T) {
+	p := newProcessor("wrapped")
+	p.Err = errors.New("processor error")
+	p.enabledFunc = func(context.Context, EnabledParameters) bool { return false }
+	s := NewTraceSamplingProcessor(p)
+
+	assert.False(t, s.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityError}))
+	r := new(Record)
+	r.SetSeverity(log.SeverityError)
+	assert.ErrorIs(t, s.OnEmit(t.Context(), r), p.Err)
+	assert.ErrorIs(t, s.ForceFlush(t.Context()), p.Err)
+	assert.ErrorIs(t, s.Shutdown(t.Context()), p.Err)
+	assert.Equal(t, 1, p.forceFlushCalls)
+	assert.Equal(t, 1, p.shutdownCalls)
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/log/internal/attrnorm"
+)
+
+// TruncatedKey is the attribute key added to log records with a body or
+// attribute values truncated due to limits. See [WithTruncatedAttribute].
+const TruncatedKey attribute.Key = "otel.log.truncated"
+
+// truncateBody returns v with the body length and value depth limits of r
+// applied, and whether v was truncated.
+func (r *Record) truncateBody(v attribute.Value) (attribute.Value, bool) {
+	truncated := false
+	if r.bodyLengthLimit > 0 {
+		v, truncated = attrnorm.TruncateValue(r.bodyLengthLimit, v)
+	}
+	if r.valueDepthLimit > 0 {
+		var t bool
+		v, t = limitDepth(r.valueDepthLimit, v)
+		truncated = truncated || t
+	}
+	return v, truncated
+}
+
+// truncateValue returns v with the attribute value length and value depth
+// limits of r applied, and whether v was truncated.
+func (r *Record) truncateValue(v attribute.Value) (attribute.Value, bool) {
+	truncated := false
+	if r.attributeValueLengthLimit >= 0 {
+		v, truncated = attrnorm.TruncateValue(r.attributeValueLengthLimit, v)
+	}
+	if r.valueDepthLimit > 0 {
+		var t bool
+		v, t = limitDepth(r.valueDepthLimit, v)
+		truncated = truncated || t
+	}
+	return v, truncated
+}
+
+// limitDepth returns v with the slice and map values nested more than limit
+// levels deep replaced by an empty value, and whether any was replaced. A
+// slice or map value v is at the first level.
+func limitDepth(limit int, v attribute.Value) (attribute.Value, bool) {
+	switch v.Type() {
+	case attribute.SLICE:
+		if limit <= 0 {
+			return attribute.Value{}, true
+		}
+		elems := v.AsSlice()
+		truncated := false
+		for i, e := range elems {
+			var t bool
+			elems[i], t = limitDepth(limit-1, e)
+			truncated = truncated || t
+		}
+		if !truncated {
+			return v, false
+		}
+		return attribute.SliceValue(elems...), true
+	case attribute.MAP:
+		if limit <= 0 {
+			return attribute.Value{}, true
+		}
+		kvs := v.AsMap()
+		truncated := false
+		for i, kv := range kvs {
+			var t bool
+			kvs[i].Value, t = limitDepth(limit-1, kv.Value)
+			truncated = truncated || t
+		}
+		if !truncated {
+			return v, false
+		}
+		return attribute.MapValue(kvs...), true
+	default:
+		return v, false
+	}
+}
+
+// setTruncated handles a truncation of the body or an attribute value of r
+// due to limits, adding the [TruncatedKey] attribute to r if configured to do
+// so.
+func (r *Record) setTruncated() {
+	logValueTruncated()
+	if r.deferTruncated {
+		r.truncatedDeferred = true
+		return
+	}
+	r.addTruncatedAttr()
+}
+
+// flushTruncated stops deferring the addition of the [TruncatedKey] attribute
+// to r, and adds it if a truncation was handled while deferred.
+func (r *Record) flushTruncated() {
+	r.deferTruncated = false
+	if r.truncatedDeferred {
+		r.truncatedDeferred = false
+		r.addTruncatedAttr()
+	}
+}
+
+// addTruncatedAttr adds the [TruncatedKey] attribute to r if configured to do
+// so and not added yet.
+func (r *Record) addTruncatedAttr() {
+	if !r.truncatedAttr || r.hasTruncatedAttr {
+		return
+	}
+	r.hasTruncatedAttr = true
+	r.AddAttributes(TruncatedKey.Bool(true))
+}
@@ -0,0 +1,277 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func TestRecordBodyLengthLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		body  attribute.Value
+		want  attribute.Value
+	}{
+		{
+			name:  "NoLimit",
+			limit: 0,
+			body:  attribute.StringValue("value"),
+			want:  attribute.StringValue("value"),
+		},
+		{
+			name:  "String",
+			limit: 3,
+			body:  attribute.StringValue("value"),
+			want:  attribute.StringValue("val"),
+		},
+		{
+			name:  "ByteSlice",
+			limit: 3,
+			body:  attribute.ByteSliceValue([]byte("value")),
+			want:  attribute.ByteSliceValue([]byte("val")),
+		},
+		{
+			name:  "Map",
+			limit: 3,
+			body:  attribute.MapValue(attribute.String("k", "value"), attribute.Int("n", 1)),
+			want:  attribute.MapValue(attribute.String("k", "val"), attribute.Int("n", 1)),
+		},
+		{
+			name:  "Int",
+			limit: 1,
+			body:  attribute.Int64Value(12345),
+			want:  attribute.Int64Value(12345),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Record{attributeValueLengthLimit: -1, bodyLengthLimit: tc.limit}
+			r.SetBody(tc.body)
+			assert.Equal(t, tc.want, r.Body())
+			assert.Equal(t, tc.body != tc.want, r.bodyTruncated)
+		})
+	}
+}
+
+func TestRecordValueDepthLimit(t *testing.T) {
+	nested := attribute.MapValue(
+		attribute.String("k", "v"),
+		attribute.Map("m", attribute.Slice("s", attribute.IntValue(1))),
+	)
+
+	tests := []struct {
+		name  string
+		limit int
+		want  attribute.Value
+	}{
+		{
+			name:  "NoLimit",
+			limit: 0,
+			want:  nested,
+		},
+		{
+			name:  "Deep",
+			limit: 3,
+			want:  nested,
+		},
+		{
+			name:  "Two",
+			limit: 2,
+			want: attribute.MapValue(
+				attribute.String("k", "v"),
+				attribute.KeyValue{Key: "m", Value: attribute.MapValue(attribute.KeyValue{Key: "s"})},
+			),
+		},
+		{
+			name:  "One",
+			limit: 1,
+			want: attribute.MapValue(
+				attribute.String("k", "v"),
+				attribute.KeyValue{Key: "m"},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Record{
+				attributeCountLimit:       -1,
+				attributeValueLengthLimit: -1,
+				valueDepthLimit:           tc.limit,
+			}
+			r.SetBody(nested)
+			r.AddAttributes(attribute.KeyValue{Key: "attr", Value: nested})
+
+			assert.Equal(t, tc.want, r.Body(), "body")
+			require.Equal(t, 1, r.AttributesLen())
+			r.WalkAttributes(func(kv attribute.KeyValue) bool {
+				assert.Equal(t, tc.want, kv.Value, "attribute")
+				return true
+			})
+		})
+	}
+
+	got, truncated := limitDepth(1, attribute.SliceValue(attribute.SliceValue(), attribute.StringValue("v")))
+	assert.True(t, truncated)
+	assert.Equal(t, attribute.SliceValue(attribute.Value{}, attribute.StringValue("v")), got)
+}
+
+func truncatedAttr(r *Record) (attribute.Value, bool) {
+	var v attribute.Value
+	var found bool
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		if kv.Key == TruncatedKey {
+			v, found = kv.Value, true
+			return false
+		}
+		return true
+	})
+	return v, found
+}
+
+func TestRecordTruncatedAttribute(t *testing.T) {
+	newRecord := func(mark bool) *Record {
+		return &Record{
+			attributeCountLimit:       -1,
+			attributeValueLengthLimit: 3,
+			bodyLengthLimit:           3,
+			truncatedAttr:             mark,
+		}
+	}
+
+	t.Run("NotTruncated", func(t *testing.T) {
+		r := newRecord(true)
+		r.SetBody(attribute.StringValue("v"))
+		r.AddAttributes(attribute.String("k", "v"))
+		_, found := truncatedAttr(r)
+		assert.False(t, found)
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		r := newRecord(true)
+		nan := attribute.Float64SliceValue([]float64{math.NaN()})
+		r.SetBody(nan)
+		r.AddAttributes(attribute.KeyValue{Key: "k", Value: nan})
+		_, found := truncatedAttr(r)
+		assert.False(t, found)
+	})
+
+	t.Run("Body", func(t *testing.T) {
+		r := newRecord(true)
+		r.SetBody(attribute.StringValue("value"))
+		v, found := truncatedAttr(r)
+		assert.True(t, found)
+		assert.Equal(t, attribute.BoolValue(true), v)
+	})
+
+	t.Run("Attributes", func(t *testing.T) {
+		r := newRecord(true)
+		r.AddAttributes(attribute.String("a", "value"))
+		r.AddAttributes(attribute.String("b", "value"))
+		_, found := truncatedAttr(r)
+		assert.True(t, found)
+		assert.Equal(t, 3, r.AttributesLen(), "added once")
+	})
+
+	t.Run("SetAttributes", func(t *testing.T) {
+		r := newRecord(true)
+		r.SetBody(attribute.StringValue("value"))
+		r.SetAttributes(attribute.String("k", "v"))
+		_, found := truncatedAttr(r)
+		assert.True(t, found, "body truncated")
+
+		r = newRecord(true)
+		r.AddAttributes(attribute.String("a", "value"))
+		r.SetAttributes(attribute.String("k", "v"))
+		_, found = truncatedAttr(r)
+		assert.False(t, found, "attributes replaced")
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		r := newRecord(false)
+		r.SetBody(attribute.StringValue("value"))
+		r.AddAttributes(attribute.String("a", "value"))
+		_, found := truncatedAttr(r)
+		assert.False(t, found)
+	})
+
+	t.Run("CountLimit", func(t *testing.T) {
+		r := newRecord(true)
+		r.attributeCountLimit = 1
+		r.AddAttributes(attribute.String("a", "value"))
+		_, found := truncatedAttr(r)
+		assert.False(t, found)
+		assert.Equal(t, 1, r.DroppedAttributes())
+	})
+}
+
+func TestLoggerTruncation(t *testing.T) {
+	p := newProcessor("processor")
+	provider := NewLoggerProvider(
+		WithProcessor(p),
+		WithBodyLengthLimit(4),
+		WithValueDepthLimit(1),
+		WithTruncatedAttribute(),
+	)
+	l := newLogger(provider, instrumentation.Scope{Name: "scope"})
+
+	var r log.Record
+	r.SetBody(attribute.StringValue(string(make([]byte, 1<<20))))
+	r.AddAttributes(attribute.Map("m", attribute.Map("n", attribute.Int("i", 1))))
+	l.Emit(t.Context(), r)
+
+	require.Len(t, p.records, 1)
+	got := p.records[0]
+	assert.Len(t, got.Body().AsString(), 4)
+	v, found := truncatedAttr(&got)
+	assert.True(t, found)
+	assert.Equal(t, attribute.BoolValue(true), v)
+	assert.Equal(t, 2, got.AttributesLen())
+	got.WalkAttributes(func(kv attribute.KeyValue) bool {
+		if kv.Key == "m" {
+			assert.Equal(t, attribute.MapValue(attribute.KeyValue{Key: "n"}), kv.Value)
+		}
+		return true
+	})
+}
+
+func TestLoggerTruncatedAttributeCountLimit(t *testing.T) {
+	p := newProcessor("processor")
+	provider := NewLoggerProvider(
+		WithProcessor(p),
+		WithAttributeCountLimit(2),
+		WithAttributeValueLengthLimit(2),
+		WithBodyLengthLimit(2),
+		WithTruncatedAttribute(),
+	)
+	l := newLogger(provider, instrumentation.Scope{Name: "scope"})
+
+	var r log.Record
+	r.SetBody(attribute.StringValue("value"))
+	r.AddAttributes(attribute.String("a", "value"))
+	r.AddAttributes(attribute.String("b", "v"))
+	l.Emit(t.Context(), r)
+
+	require.Len(t, p.records, 1)
+	got := p.records[0]
+	var keys []attribute.Key
+	got.WalkAttributes(func(kv attribute.KeyValue) bool {
+		keys = append(keys, kv.Key)
+		return true
+	})
+	// The attributes of the caller take precedence over the TruncatedKey
+	// attribute, which is dropped instead.
+	assert.Equal(t, []attribute.Key{"a", "b"}, keys)
+	assert.Equal(t, 1, got.DroppedAttributes())
+}
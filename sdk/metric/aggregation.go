@@ -6,6 +6,7 @@ package metric
 import (
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 )
 
@@ -187,3 +188,92 @@ func (e AggregationBase2ExponentialHistogram) err() error {
 	}
 	return nil
 }
+
+// AggregationSummary is an Aggregation that summarizes a set of measurements
+// as quantiles of their distribution.
+//
+// The quantiles are estimated using a DDSketch, a mergeable sketch with
+// logarithmically sized buckets. Any estimated quantile value has a relative
+// error of at most RelativeAccuracy as long as the number of buckets needed
+// does not exceed MaxSize. When it does, the lowest buckets are collapsed and
+// only the accuracy of the lowest quantiles is reduced.
+//
+// The count, sum, and quantiles are calculated over the values of the
+// collection cycle for delta temporality and over all values since the
+// start of the timeseries for cumulative temporality.
+//
+// Summary quantiles cannot be negative. Negative measurements are ignored.
+type AggregationSummary struct {
+	// Quantiles are the strictly increasing quantiles in the interval
+	// [0, 1] to report. For example, []float64{0.5, 0.99} reports the p50
+	// and p99 of the distribution. The quantiles 0 and 1 report the exact
+	// minimum and maximum values recorded.
+	//
+	// If Quantiles is empty, only the count and sum are reported.
+	Quantiles []float64
+	// RelativeAccuracy is the maximum relative error of any estimated
+	// quantile value. It needs to be in the interval (0, 1).
+	//
+	// If RelativeAccuracy is zero, 0.01 (1%) is used.
+	RelativeAccuracy float64
+	// MaxSize is the maximum number of buckets to use for the sketch.
+	//
+	// If MaxSize is zero, 2048 is used. Using a relative accuracy of 1%,
+	// this covers values spanning more than 17 orders of magnitude without
+	// any loss of accuracy.
+	MaxSize int32
+}
+
+var _ Aggregation = AggregationSummary{}
+
+const (
+	defaultSummaryRelativeAccuracy = 0.01
+	defaultSummaryMaxSize          = 2048
+)
+
+// errSummary is returned by misconfigured Summaries.
+var errSummary = fmt.Errorf("%w: summary", errAgg)
+
+// copy returns a deep copy of s.
+func (s AggregationSummary) copy() Aggregation {
+	return AggregationSummary{
+		Quantiles:        slices.Clone(s.Quantiles),
+		RelativeAccuracy: s.RelativeAccuracy,
+		MaxSize:          s.MaxSize,
+	}
+}
+
+// err returns an error for any misconfiguration.
+func (s AggregationSummary) err() error {
+	for i, q := range s.Quantiles {
+		if q < 0 || q > 1 || math.IsNaN(q) {
+			return fmt.Errorf("%w: quantile %v not in [0, 1]", errSummary, q)
+		}
+		if i > 0 && q <= s.Quantiles[i-1] {
+			return fmt.Errorf("%w: non-monotonic quantiles: %v", errSummary, s.Quantiles)
+		}
+	}
+	if s.RelativeAccuracy < 0 || s.RelativeAccuracy >= 1 || math.IsNaN(s.RelativeAccuracy) {
+		return fmt.Errorf("%w: relative accuracy %v not in (0, 1)", errSummary, s.RelativeAccuracy)
+	}
+	if s.MaxSize < 0 {
+		return fmt.Errorf("%w: max size %d is less than zero", errSummary, s.MaxSize)
+	}
+	return nil
+}
+
+// relativeAccuracy returns the configured relative accuracy or its default.
+func (s AggregationSummary) relativeAccuracy() float64 {
+	if s.RelativeAccuracy == 0 {
+		return defaultSummaryRelativeAccuracy
+	}
+	return s.RelativeAccuracy
+}
+
+// maxSize returns the configured max size or its default.
+func (s AggregationSummary) maxSize() int {
+	if s.MaxSize == 0 {
+		return defaultSummaryMaxSize
+	}
+	return int(s.MaxSize)
+}
@@ -453,6 +453,16 @@ func BenchmarkEndToEndCounterAdd(b *testing.B) {
 			provider: noFilterProvider,
 			fn:       benchNaiveWithAttributes,
 		},
+		{
+			name:     "NoFilter/Bound",
+			provider: noFilterProvider,
+			fn:       benchBound,
+		},
+		{
+			name:     "Filtered/Bound",
+			provider: filteredProvider,
+			fn:       benchBound,
+		},
 		{
 			name:     "Filtered/Precomputed/WithAttributeSet",
 			provider: filteredProvider,
@@ -485,6 +495,16 @@ func benchPrecomputedWithAttributeSet(b *testing.B, ctx context.Context, counter
 	})
 }
 
+func benchBound(b *testing.B, ctx context.Context, counter metric.Float64Counter) {
+	bound := x.BindFloat64Counter(counter, attribute.NewSet(attributes()...))
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			bound.Add(ctx, 1)
+		}
+	})
+}
+
 func benchPrecomputedWithAttributes(b *testing.B, ctx context.Context, counter metric.Float64Counter) {
 	precomputedOpts := []metric.AddOption{metric.WithAttributes(attributes()...)}
 	b.ReportAllocs()
@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
+)
+
+var (
+	errCallbackTimeout = errors.New("callback timed out")
+	errCallbackSkipped = errors.New("callback skipped")
+	errCallbackRunning = errors.New("previous run still in progress")
+)
+
+// callbackConfig configures how a pipeline runs the callbacks of observable
+// instruments.
+type callbackConfig struct {
+	// timeout is the maximum duration of a single callback. If it is less
+	// than or equal to zero, callbacks are not timed out.
+	timeout time.Duration
+	// concurrency is the maximum number of callbacks run concurrently. If it
+	// is less than or equal to one, callbacks are run sequentially.
+	concurrency int
+}
+
+// callback is a callback of observable instruments registered with a
+// pipeline. Observations made by the callback need to use guard.
+type callback func(ctx context.Context, guard *callbackGuard) error
+
+// singleFlight returns c wrapped so it is not run while a previous run of it,
+// abandoned after timing out, has not returned yet. Such runs return
+// errCallbackRunning instead. This bounds the goroutines of a callback that
+// blocks and ignores the cancellation of its context to one.
+func singleFlight(c callback) callback {
+	var running atomic.Bool
+	return func(ctx context.Context, guard *callbackGuard) error {
+		if !running.CompareAndSwap(false, true) {
+			return errCallbackRunning
+		}
+		defer running.Store(false)
+		return c(ctx, guard)
+	}
+}
+
+// callbackGuard guards the observations made during a single run of a
+// callback. Once the guard has expired, observations are dropped. This
+// ensures a callback that has not returned in time cannot record into a
+// following collection cycle.
+//
+// A nil *callbackGuard never expires.
+type callbackGuard struct {
+	mu      sync.RWMutex
+	expired bool
+}
+
+// begin reports whether an observation can be made. If true is returned, end
+// needs to be called once the observation is done.
+func (g *callbackGuard) begin() bool {
+	if g == nil {
+		return true
+	}
+	g.mu.RLock()
+	if g.expired {
+		g.mu.RUnlock()
+		return false
+	}
+	return true
+}
+
+// end completes an observation started with begin.
+func (g *callbackGuard) end() {
+	if g != nil {
+		g.mu.RUnlock()
+	}
+}
+
+// expire expires g. Once this returns, no observation is in progress and
+// all following observations are dropped.
+func (g *callbackGuard) expire() {
+	g.mu.Lock()
+	g.expired = true
+	g.mu.Unlock()
+}
+
+// runCallbacks runs all callbacks registered with p. The errors returned by
+// the callbacks are returned.
+//
+// Callbacks that time out, or are skipped because ctx is done, are reported
+// to the global error handler and with the self-observability of the reader.
+// They are not returned so the data of all other instruments is still
+// exported.
+//
+// The caller needs to hold the lock of p.
+func (p *pipeline) runCallbacks(ctx context.Context) error {
+	n := len(p.callbacks) + p.multiCallbacks.Len()
+	if p.callbackConfig.concurrency <= 1 || n <= 1 {
+		var err error
+		for _, c := range p.callbacks {
+			err = errors.Join(err, p.runCallback(ctx, c))
+		}
+		for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+			err = errors.Join(err, p.runCallback(ctx, e.Value.(callback)))
+		}
+		return err
+	}
+
+	callbacks := make([]callback, 0, n)
+	callbacks = append(callbacks, p.callbacks...)
+	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+		callbacks = append(callbacks, e.Value.(callback))
+	}
+
+	var (
+		wg    sync.WaitGroup
+		errMu sync.Mutex
+		err   error
+	)
+	sem := make(chan struct{}, p.callbackConfig.concurrency)
+	for _, c := range callbacks {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			if e := p.runCallback(ctx, c); e != nil {
+				errMu.Lock()
+				err = errors.Join(err, e)
+				errMu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return err
+}
+
+// runCallback runs c, applying the callback timeout of p if one is set.
+func (p *pipeline) runCallback(ctx context.Context, c callback) error {
+	timeout := p.callbackConfig.timeout
+	if timeout <= 0 {
+		return c(ctx, nil)
+	}
+
+	if ctx.Err() != nil {
+		p.callbackFailed(ctx, fmt.Errorf("%w: %w", errCallbackSkipped, context.Cause(ctx)), "skipped")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, timeout, errCallbackTimeout)
+	defer cancel()
+
+	guard := new(callbackGuard)
+	done := make(chan error, 1)
+	go func() { done <- c(ctx, guard) }()
+
+	select {
+	case err := <-done:
+		if errors.Is(err, errCallbackRunning) {
+			p.callbackFailed(ctx, fmt.Errorf("%w: %w", errCallbackSkipped, err), "skipped")
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		guard.expire()
+		// The callback may have returned while the guard was expiring.
+		select {
+		case err := <-done:
+			return err
+		default:
+		}
+		err := context.Cause(ctx)
+		if !errors.Is(err, errCallbackTimeout) {
+			err = fmt.Errorf("%w: %w", errCallbackTimeout, err)
+		}
+		p.callbackFailed(ctx, err, "timeout")
+		return nil
+	}
+}
+
+// instrumentedReader is a Reader with self-observability instrumentation.
+type instrumentedReader interface {
+	instrumentation() *observ.Instrumentation
+}
+
+// callbackFailed reports err for a callback that did not complete. The
+// errorType describes the failure in the self-observability of the reader.
+func (p *pipeline) callbackFailed(ctx context.Context, err error, errorType string) {
+	otel.Handle(err)
+	if r, ok := p.reader.(instrumentedReader); ok {
+		if inst := r.instrumentation(); inst != nil {
+			inst.CallbackFailed(context.WithoutCancel(ctx), errorType)
+		}
+	}
+}
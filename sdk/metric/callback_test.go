@@ -0,0 +1,274 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// errCollector is an otel.ErrorHandler that collects all errors handled.
+type errCollector struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (c *errCollector) Handle(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errs = append(c.errs, err)
+}
+
+func (c *errCollector) Errors() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]error(nil), c.errs...)
+}
+
+func setErrCollector(t *testing.T) *errCollector {
+	t.Helper()
+
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+
+	eh := new(errCollector)
+	otel.SetErrorHandler(eh)
+	return eh
+}
+
+func metricNames(rm metricdata.ResourceMetrics) []string {
+	var names []string
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names = append(names, m.Name)
+		}
+	}
+	return names
+}
+
+func TestCallbackGuard(t *testing.T) {
+	var nilGuard *callbackGuard
+	require.True(t, nilGuard.begin(), "nil guard expired")
+	nilGuard.end()
+
+	g := new(callbackGuard)
+	require.True(t, g.begin(), "new guard expired")
+	g.end()
+
+	g.expire()
+	assert.False(t, g.begin(), "expired guard allowed observation")
+}
+
+func TestCallbackTimeout(t *testing.T) {
+	eh := setErrCollector(t)
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithCallbackTimeout(10*time.Millisecond))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	meter := mp.Meter("TestCallbackTimeout")
+
+	_, err := meter.Int64ObservableCounter("fast", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	// The slow callbacks block the first collection until released, after
+	// they have timed out. Following collections are not blocked and not
+	// observed.
+	release := make(chan struct{})
+	var late sync.WaitGroup
+	late.Add(2)
+	var slowCalled, slowMultiCalled atomic.Bool
+
+	_, err = meter.Int64ObservableGauge("slow", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			if slowCalled.Swap(true) {
+				return nil
+			}
+			<-release
+			o.Observe(1)
+			late.Done()
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	slowMulti, err := meter.Float64ObservableGauge("slow.multi")
+	require.NoError(t, err)
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		if slowMultiCalled.Swap(true) {
+			return nil
+		}
+		<-release
+		o.ObserveFloat64(slowMulti, 1)
+		late.Done()
+		return nil
+	}, slowMulti)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm), "timed out callbacks returned")
+	assert.Equal(t, []string{"fast"}, metricNames(rm))
+
+	errs := eh.Errors()
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, errCallbackTimeout)
+	}
+
+	// Observations made after the timeout need to be dropped and not appear
+	// in the following collection.
+	close(release)
+	late.Wait()
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	assert.Equal(t, []string{"fast"}, metricNames(rm))
+}
+
+func TestCallbackTimeoutSingleFlight(t *testing.T) {
+	eh := setErrCollector(t)
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithCallbackTimeout(10*time.Millisecond))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	meter := mp.Meter("TestCallbackTimeoutSingleFlight")
+
+	// The callback ignores its context and blocks until the end of the test.
+	release := make(chan struct{})
+	var runs atomic.Int64
+	_, err := meter.Int64ObservableGauge("blocked", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error {
+			runs.Add(1)
+			<-release
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	const collections = 5
+	for range collections {
+		require.NoError(t, r.Collect(t.Context(), &rm))
+		assert.Empty(t, metricNames(rm))
+	}
+	close(release)
+
+	assert.Equal(t, int64(1), runs.Load(), "callback run again while blocked")
+
+	errs := eh.Errors()
+	require.Len(t, errs, collections)
+	assert.ErrorIs(t, errs[0], errCallbackTimeout)
+	for _, err := range errs[1:] {
+		assert.ErrorIs(t, err, errCallbackSkipped)
+		assert.ErrorIs(t, err, errCallbackRunning)
+	}
+}
+
+func TestCallbackSkipped(t *testing.T) {
+	eh := setErrCollector(t)
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithCallbackTimeout(time.Second))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	meter := mp.Meter("TestCallbackSkipped")
+
+	// The collection context is done during the first callback, the second
+	// needs to be skipped.
+	ctx, cancel := context.WithCancel(t.Context())
+	_, err := meter.Int64ObservableCounter("first", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1)
+			cancel()
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var called bool
+	_, err = meter.Int64ObservableCounter("second", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error {
+			called = true
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(ctx, &rm))
+	assert.False(t, called, "callback called with done context")
+	assert.Equal(t, []string{"first"}, metricNames(rm))
+
+	errs := eh.Errors()
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], errCallbackSkipped)
+	assert.ErrorIs(t, errs[0], context.Canceled)
+}
+
+func TestCallbackConcurrency(t *testing.T) {
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithCallbackConcurrency(2))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	meter := mp.Meter("TestCallbackConcurrency")
+
+	// Each callback waits for the other one to start. This only completes
+	// if the callbacks are run concurrently.
+	var started sync.WaitGroup
+	started.Add(2)
+	cback := func(_ context.Context, o metric.Int64Observer) error {
+		started.Done()
+		done := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+			o.Observe(1)
+		case <-time.After(time.Second):
+		}
+		return nil
+	}
+
+	_, err := meter.Int64ObservableCounter("a", metric.WithInt64Callback(cback))
+	require.NoError(t, err)
+	_, err = meter.Int64ObservableCounter("b", metric.WithInt64Callback(cback))
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	assert.ElementsMatch(t, []string{"a", "b"}, metricNames(rm), "callbacks not run concurrently")
+}
+
+func TestCallbackConcurrencyErrors(t *testing.T) {
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithCallbackConcurrency(4))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	meter := mp.Meter("TestCallbackConcurrencyErrors")
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := meter.Int64ObservableCounter(name, metric.WithInt64Callback(
+			func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(1)
+				return assert.AnError
+			},
+		))
+		require.NoError(t, err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	assert.ErrorIs(t, r.Collect(t.Context(), &rm), assert.AnError)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, metricNames(rm))
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
@@ -22,7 +23,9 @@ type config struct {
 	readers          []Reader
 	views            []View
 	exemplarFilter   exemplar.Filter
+	measFilter       exemplar.MeasurementFilter
 	cardinalityLimit int
+	callbacks        callbackConfig
 }
 
 const defaultCardinalityLimit = 2000
@@ -165,6 +168,37 @@ func WithView(views ...View) Option {
 func WithExemplarFilter(filter exemplar.Filter) Option {
 	return optionFunc(func(cfg config) config {
 		cfg.exemplarFilter = filter
+		cfg.measFilter = nil
+		return cfg
+	})
+}
+
+// WithExemplarMeasurementFilter configures an exemplar filter that decides
+// based on the value and attributes of a measurement, in addition to the
+// context it was made in.
+//
+// The exemplar filter determines which measurements are offered to the
+// exemplar reservoir, but the exemplar reservoir makes the final decision of
+// whether to store an exemplar.
+//
+// This option and [WithExemplarFilter] replace each other, the last one
+// passed is used. If filter is nil, this option has no effect.
+//
+// For example, to only offer measurements made in a sampled trace that are
+// either slower than 2 seconds or made for a failed operation:
+//
+//	WithExemplarMeasurementFilter(exemplar.AllOf(
+//		exemplar.ContextFilter(exemplar.TraceBasedFilter),
+//		exemplar.AnyOf(
+//			exemplar.ValueThresholdFilter(2),
+//			exemplar.AttributeFilter(attribute.Bool("error", true)),
+//		),
+//	))
+func WithExemplarMeasurementFilter(filter exemplar.MeasurementFilter) Option {
+	return optionFunc(func(cfg config) config {
+		if filter != nil {
+			cfg.measFilter = filter
+		}
 		return cfg
 	})
 }
@@ -189,6 +223,47 @@ func WithCardinalityLimit(limit int) Option {
 	})
 }
 
+// WithCallbackTimeout sets the maximum duration a single callback registered
+// for observable instruments is allowed to run during a collection.
+//
+// A callback that does not return within d is abandoned: the error is sent to
+// the global error handler, the observations it makes afterwards are dropped,
+// and the collection continues with the data of all other instruments. The
+// context passed to the callback is canceled once d has elapsed. Callbacks
+// that have not been started when the collection context is done are skipped
+// and reported the same way.
+//
+// An abandoned callback keeps running until it returns, as it cannot be
+// stopped. Callbacks need to return once their context is canceled. Until an
+// abandoned callback returns, it is skipped and reported the same way in the
+// following collections, so at most one run of each callback is in progress.
+//
+// By default, if this option is not used or d is less than or equal to zero,
+// callbacks are not timed out.
+func WithCallbackTimeout(d time.Duration) Option {
+	return optionFunc(func(cfg config) config {
+		if d > 0 {
+			cfg.callbacks.timeout = d
+		}
+		return cfg
+	})
+}
+
+// WithCallbackConcurrency sets the maximum number of callbacks registered for
+// observable instruments that are run concurrently during a collection.
+//
+// Callbacks need to be safe to call concurrently with each other when n is
+// greater than one.
+//
+// By default, if this option is not used or n is less than or equal to one,
+// callbacks are run sequentially.
+func WithCallbackConcurrency(n int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.callbacks.concurrency = n
+		return cfg
+	})
+}
+
 func meterProviderOptionsFromEnv() []Option {
 	var opts []Option
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/d4b241f451674e8f611bb589477680341006ad2b/specification/configuration/sdk-environment-variables.md#exemplar
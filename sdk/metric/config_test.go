@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -314,6 +315,19 @@ func TestWithExemplarFilterOff(t *testing.T) {
 	}
 }
 
+func TestWithExemplarMeasurementFilter(t *testing.T) {
+	f := exemplar.ValueThresholdFilter(1)
+
+	c := newConfig([]Option{WithExemplarMeasurementFilter(f)})
+	assert.NotNil(t, c.measFilter)
+
+	c = newConfig([]Option{WithExemplarMeasurementFilter(f), WithExemplarFilter(exemplar.AlwaysOnFilter)})
+	assert.Nil(t, c.measFilter, "WithExemplarFilter should replace the measurement filter")
+
+	c = newConfig([]Option{WithExemplarMeasurementFilter(f), WithExemplarMeasurementFilter(nil)})
+	assert.NotNil(t, c.measFilter, "nil measurement filter should be ignored")
+}
+
 func TestWithCardinalityLimit(t *testing.T) {
 	cases := []struct {
 		name          string
@@ -368,6 +382,21 @@ func TestWithCardinalityLimit(t *testing.T) {
 	}
 }
 
+func TestWithCallbackTimeout(t *testing.T) {
+	assert.Zero(t, newConfig(nil).callbacks.timeout, "default")
+	assert.Equal(t, time.Second, newConfig([]Option{WithCallbackTimeout(time.Second)}).callbacks.timeout)
+	assert.Zero(t, newConfig([]Option{WithCallbackTimeout(-time.Second)}).callbacks.timeout, "negative")
+	assert.Equal(t, time.Second, newConfig([]Option{
+		WithCallbackTimeout(time.Second),
+		WithCallbackTimeout(0),
+	}).callbacks.timeout, "zero ignored")
+}
+
+func TestWithCallbackConcurrency(t *testing.T) {
+	assert.Zero(t, newConfig(nil).callbacks.concurrency, "default")
+	assert.Equal(t, 4, newConfig([]Option{WithCallbackConcurrency(4)}).callbacks.concurrency)
+}
+
 func sample(parent context.Context) context.Context {
 	sc := trace.NewSpanContext(trace.SpanContextConfig{
 		TraceID:    trace.TraceID{0x01},
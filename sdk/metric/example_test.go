@@ -243,6 +243,30 @@ func ExampleNewView_exponentialHistogram() {
 	)
 }
 
+func ExampleNewView_summary() {
+	// Create a view that makes the "latency" instrument from the "http"
+	// instrumentation library to be reported as a summary of its p50, p90,
+	// and p99 with a relative error of at most 1%.
+	view := metric.NewView(
+		metric.Instrument{
+			Name:  "latency",
+			Scope: instrumentation.Scope{Name: "http"},
+		},
+		metric.Stream{
+			Aggregation: metric.AggregationSummary{
+				Quantiles:        []float64{0.5, 0.9, 0.99},
+				RelativeAccuracy: 0.01,
+			},
+		},
+	)
+
+	// The created view can then be registered with the OpenTelemetry metric
+	// SDK using the WithView option.
+	_ = metric.NewMeterProvider(
+		metric.WithView(view),
+	)
+}
+
 func ExampleNewView_exemplarreservoirproviderselector() {
 	// Create a view that makes all metrics use a different exemplar reservoir.
 	view := metric.NewView(
@@ -19,11 +19,19 @@ type ExemplarReservoirProviderSelector func(Aggregation) exemplar.ReservoirProvi
 
 // reservoirFunc returns the appropriately configured exemplar reservoir
 // creation func based on the passed InstrumentKind and filter configuration.
+//
+// If measFilter is not nil, it is used instead of filter.
 func reservoirFunc[N int64 | float64](
 	kind InstrumentKind,
 	provider exemplar.ReservoirProvider,
 	filter exemplar.Filter,
+	measFilter exemplar.MeasurementFilter,
 ) func(attribute.Set) aggregate.FilteredExemplarReservoir[N] {
+	if measFilter != nil {
+		return func(attrs attribute.Set) aggregate.FilteredExemplarReservoir[N] {
+			return aggregate.NewMeasurementFilteredExemplarReservoir[N](measFilter, provider(attrs))
+		}
+	}
 	if reflect.ValueOf(filter).Pointer() == reflect.ValueOf(exemplar.AlwaysOffFilter).Pointer() {
 		return aggregate.DropReservoir[N]
 	}
@@ -5,7 +5,9 @@ package exemplar
 
 import (
 	"context"
+	"slices"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -32,3 +34,84 @@ func AlwaysOnFilter(context.Context) bool {
 func AlwaysOffFilter(context.Context) bool {
 	return false
 }
+
+// MeasurementFilter determines if a measurement should be offered based on
+// the measurement itself.
+//
+// The passed ctx needs to contain any baggage or span that were active when
+// the measurement was made. The val is the value of the measurement and attrs
+// are the complete set of attributes the measurement was made with, including
+// attributes that are later dropped by a View.
+//
+// A MeasurementFilter is called for every measurement made. It needs to be
+// safe to call concurrently and should return quickly.
+type MeasurementFilter func(ctx context.Context, val Value, attrs attribute.Set) bool
+
+// ContextFilter returns a [MeasurementFilter] that offers measurements
+// based only on the context they were made in, as decided by f.
+//
+// This can be used to combine a [Filter], like [TraceBasedFilter], with
+// other measurement filters.
+func ContextFilter(f Filter) MeasurementFilter {
+	return func(ctx context.Context, _ Value, _ attribute.Set) bool {
+		return f(ctx)
+	}
+}
+
+// ValueThresholdFilter returns a [MeasurementFilter] that only offers
+// measurements with a value greater than or equal to threshold.
+//
+// This can be used to only keep exemplars for outliers. For example, with a
+// threshold equal to the 95th percentile latency of a service only the
+// slowest 5% of requests are offered.
+func ValueThresholdFilter(threshold float64) MeasurementFilter {
+	return func(_ context.Context, val Value, _ attribute.Set) bool {
+		return valueFloat64(val) >= threshold
+	}
+}
+
+// AttributeFilter returns a [MeasurementFilter] that only offers
+// measurements made with the attribute kv.
+//
+// For example, AttributeFilter(attribute.Bool("error", true)) only offers
+// measurements of failed operations.
+func AttributeFilter(kv attribute.KeyValue) MeasurementFilter {
+	return func(_ context.Context, _ Value, attrs attribute.Set) bool {
+		v, ok := attrs.Value(kv.Key)
+		return ok && v == kv.Value
+	}
+}
+
+// AllOf returns a [MeasurementFilter] that only offers measurements all of
+// filters offer. The filters are evaluated in order and evaluation stops at
+// the first filter that does not offer the measurement.
+//
+// If no filters are passed, all measurements are offered.
+func AllOf(filters ...MeasurementFilter) MeasurementFilter {
+	filters = slices.Clone(filters)
+	return func(ctx context.Context, val Value, attrs attribute.Set) bool {
+		for _, f := range filters {
+			if !f(ctx, val, attrs) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf returns a [MeasurementFilter] that offers measurements any of
+// filters offer. The filters are evaluated in order and evaluation stops at
+// the first filter that offers the measurement.
+//
+// If no filters are passed, no measurements are offered.
+func AnyOf(filters ...MeasurementFilter) MeasurementFilter {
+	filters = slices.Clone(filters)
+	return func(ctx context.Context, val Value, attrs attribute.Set) bool {
+		for _, f := range filters {
+			if f(ctx, val, attrs) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -44,3 +45,58 @@ func testAlwaysOnFiltered[N int64 | float64](t *testing.T) {
 	assert.True(t, AlwaysOnFilter(ctx), "non-sampled context should not be offered")
 	assert.True(t, AlwaysOnFilter(sample(ctx)), "sampled context should be offered")
 }
+
+func TestContextFilter(t *testing.T) {
+	f := ContextFilter(TraceBasedFilter)
+	ctx := t.Context()
+	attrs := *attribute.EmptySet()
+
+	assert.False(t, f(ctx, NewValue[int64](1), attrs), "non-sampled context should not be offered")
+	assert.True(t, f(sample(ctx), NewValue[int64](1), attrs), "sampled context should be offered")
+}
+
+func TestValueThresholdFilter(t *testing.T) {
+	f := ValueThresholdFilter(10)
+	ctx := t.Context()
+	attrs := *attribute.EmptySet()
+
+	assert.False(t, f(ctx, NewValue[int64](9), attrs), "int64 below threshold")
+	assert.True(t, f(ctx, NewValue[int64](10), attrs), "int64 equal to threshold")
+	assert.True(t, f(ctx, NewValue[int64](11), attrs), "int64 above threshold")
+	assert.False(t, f(ctx, NewValue(9.9), attrs), "float64 below threshold")
+	assert.True(t, f(ctx, NewValue(10.1), attrs), "float64 above threshold")
+}
+
+func TestAttributeFilter(t *testing.T) {
+	f := AttributeFilter(attribute.Bool("error", true))
+	ctx := t.Context()
+	v := NewValue[int64](1)
+
+	assert.True(t, f(ctx, v, attribute.NewSet(attribute.Bool("error", true), attribute.String("k", "v"))))
+	assert.False(t, f(ctx, v, attribute.NewSet(attribute.Bool("error", false))), "different value")
+	assert.False(t, f(ctx, v, attribute.NewSet(attribute.String("error", "true"))), "different type")
+	assert.False(t, f(ctx, v, *attribute.EmptySet()), "missing attribute")
+}
+
+func TestAllOf(t *testing.T) {
+	ctx := t.Context()
+	attrs := attribute.NewSet(attribute.Bool("error", true))
+	errFilter := AttributeFilter(attribute.Bool("error", true))
+	f := AllOf(ContextFilter(TraceBasedFilter), errFilter)
+
+	assert.True(t, f(sample(ctx), NewValue[int64](1), attrs))
+	assert.False(t, f(ctx, NewValue[int64](1), attrs), "first filter not offered")
+	assert.False(t, f(sample(ctx), NewValue[int64](1), *attribute.EmptySet()), "second filter not offered")
+	assert.True(t, AllOf()(ctx, NewValue[int64](1), attrs), "empty AllOf should offer")
+}
+
+func TestAnyOf(t *testing.T) {
+	ctx := t.Context()
+	errAttrs := attribute.NewSet(attribute.Bool("error", true))
+	f := AnyOf(ValueThresholdFilter(100), AttributeFilter(attribute.Bool("error", true)))
+
+	assert.True(t, f(ctx, NewValue[int64](100), *attribute.EmptySet()), "first filter offered")
+	assert.True(t, f(ctx, NewValue[int64](1), errAttrs), "second filter offered")
+	assert.False(t, f(ctx, NewValue[int64](1), *attribute.EmptySet()), "no filter offered")
+	assert.False(t, AnyOf()(ctx, NewValue[int64](1), errAttrs), "empty AnyOf should not offer")
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplar
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/internal/reservoir"
+)
+
+// MaxValueReservoirProvider returns a provider of [MaxValueReservoir].
+func MaxValueReservoirProvider(k int) ReservoirProvider {
+	return func(attribute.Set) Reservoir {
+		return NewMaxValueReservoir(k)
+	}
+}
+
+// NewMaxValueReservoir returns a [MaxValueReservoir] that samples the k
+// measurements with the largest values made in a collection cycle.
+func NewMaxValueReservoir(k int) *MaxValueReservoir {
+	return &MaxValueReservoir{storage: make([]measurement, max(k, 0))}
+}
+
+var _ Reservoir = &MaxValueReservoir{}
+
+// MaxValueReservoir is a [Reservoir] that samples the k measurements with the
+// largest values made in a collection cycle. Unlike random sampling, this
+// ensures the outliers of a distribution, like the slowest requests, are
+// always sampled.
+//
+// Exemplars from a previous collection cycle are preserved until they are
+// replaced by measurements made in the current cycle.
+type MaxValueReservoir struct {
+	reservoir.ConcurrentSafe
+	mu      sync.Mutex
+	storage []measurement
+	// count is the number of measurements stored in the current collection
+	// cycle. They are held in storage[:count].
+	count int
+	// minIdx is the index of the smallest value held once storage is full.
+	minIdx int
+}
+
+// Offer accepts the parameters associated with a measurement. The
+// parameters will be stored as an exemplar if the Reservoir decides to
+// sample the measurement.
+//
+// The passed ctx needs to contain any baggage or span that were active
+// when the measurement was made. This information may be used by the
+// Reservoir in making a sampling decision.
+//
+// The time t is the time when the measurement was made. The v and a
+// parameters are the value and dropped (filtered) attributes of the
+// measurement respectively.
+func (r *MaxValueReservoir) Offer(ctx context.Context, t time.Time, v Value, a []attribute.KeyValue) {
+	if len(r.storage) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.count < len(r.storage) {
+		r.storage[r.count].store(ctx, t, v, a)
+		r.count++
+		if r.count == len(r.storage) {
+			r.updateMin()
+		}
+		return
+	}
+
+	if valueFloat64(v) <= valueFloat64(r.storage[r.minIdx].Value) {
+		return
+	}
+	r.storage[r.minIdx].store(ctx, t, v, a)
+	r.updateMin()
+}
+
+// updateMin sets r.minIdx to the index of the smallest value held.
+func (r *MaxValueReservoir) updateMin() {
+	r.minIdx = 0
+	minVal := valueFloat64(r.storage[0].Value)
+	for i := 1; i < len(r.storage); i++ {
+		if v := valueFloat64(r.storage[i].Value); v < minVal {
+			r.minIdx, minVal = i, v
+		}
+	}
+}
+
+// Collect returns all the held exemplars.
+//
+// The stored exemplars are preserved after this call, but the sampling state
+// is reset. The next measurements offered replace them regardless of their
+// value.
+func (r *MaxValueReservoir) Collect(dest *[]Exemplar) {
+	if len(r.storage) == 0 {
+		*dest = (*dest)[:0]
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*dest = reset(*dest, len(r.storage), len(r.storage))
+	var n int
+	for i := range r.storage {
+		if r.storage[i].exemplar(&(*dest)[n]) {
+			n++
+		}
+	}
+	*dest = (*dest)[:n]
+	r.count = 0
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplar
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMaxValueReservoir(t *testing.T) {
+	t.Run("Int64", ReservoirTest[int64](func(n int) (ReservoirProvider, int) {
+		return MaxValueReservoirProvider(n), n
+	}))
+
+	t.Run("Float64", ReservoirTest[float64](func(n int) (ReservoirProvider, int) {
+		return MaxValueReservoirProvider(n), n
+	}))
+}
+
+func TestMaxValueReservoirConcurrentSafe(t *testing.T) {
+	t.Run("Int64", reservoirConcurrentSafeTest[int64](func(n int) (ReservoirProvider, int) {
+		return MaxValueReservoirProvider(n), n
+	}))
+	t.Run("Float64", reservoirConcurrentSafeTest[float64](func(n int) (ReservoirProvider, int) {
+		return MaxValueReservoirProvider(n), n
+	}))
+}
+
+func collectValues(r Reservoir) []float64 {
+	var dest []Exemplar
+	r.Collect(&dest)
+	values := make([]float64, len(dest))
+	for i, e := range dest {
+		values[i] = valueFloat64(e.Value)
+	}
+	slices.Sort(values)
+	return values
+}
+
+func TestMaxValueReservoirKeepsMax(t *testing.T) {
+	const k = 5
+	r := NewMaxValueReservoir(k)
+
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i] = float64(i)
+	}
+	rand.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+	for _, v := range values {
+		r.Offer(t.Context(), staticTime, NewValue(v), nil)
+	}
+
+	assert.Equal(t, []float64{995, 996, 997, 998, 999}, collectValues(r))
+}
+
+func TestMaxValueReservoirMixedSigns(t *testing.T) {
+	r := NewMaxValueReservoir(2)
+	for _, v := range []int64{-5, -1, -10, 3, -2} {
+		r.Offer(t.Context(), staticTime, NewValue(v), nil)
+	}
+	assert.Equal(t, []float64{-1, 3}, collectValues(r))
+}
+
+func TestMaxValueReservoirCollectResets(t *testing.T) {
+	r := NewMaxValueReservoir(2)
+	for _, v := range []int64{100, 200} {
+		r.Offer(t.Context(), staticTime, NewValue(v), nil)
+	}
+	require.Equal(t, []float64{100, 200}, collectValues(r))
+
+	// Exemplars are preserved until replaced.
+	require.Equal(t, []float64{100, 200}, collectValues(r))
+
+	// Smaller values of the next cycle replace the previous ones.
+	r.Offer(t.Context(), staticTime, NewValue[int64](1), nil)
+	assert.Equal(t, []float64{1, 200}, collectValues(r))
+	r.Offer(t.Context(), staticTime, NewValue[int64](2), nil)
+	r.Offer(t.Context(), staticTime, NewValue[int64](3), nil)
+	assert.Equal(t, []float64{2, 3}, collectValues(r))
+}
+
+func TestNewMaxValueReservoirNegativeSize(t *testing.T) {
+	r := NewMaxValueReservoir(-1)
+	r.Offer(t.Context(), staticTime, NewValue[int64](1), nil)
+	assert.Empty(t, collectValues(r))
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplar
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/internal/reservoir"
+)
+
+// TimeBucketedReservoirProvider returns a provider of
+// [TimeBucketedReservoir].
+func TimeBucketedReservoirProvider(interval time.Duration, n int) ReservoirProvider {
+	return func(attribute.Set) Reservoir {
+		return NewTimeBucketedReservoir(interval, n)
+	}
+}
+
+type timeBucket struct {
+	mu sync.Mutex
+	nt nextTracker
+	// id identifies the time bucket the held measurement belongs to.
+	id int64
+	measurement
+}
+
+// NewTimeBucketedReservoir returns a [TimeBucketedReservoir] that samples one
+// measurement for each time bucket of length interval, holding the n most
+// recent buckets.
+//
+// The time buckets are aligned to the Unix epoch. If interval is less than or
+// equal to zero, all measurements share a single bucket. If n is less than
+// one, no measurements are sampled.
+func NewTimeBucketedReservoir(interval time.Duration, n int) *TimeBucketedReservoir {
+	buckets := make([]timeBucket, max(n, 0))
+	for i := range buckets {
+		buckets[i].nt.k = 1
+		buckets[i].nt.reset()
+	}
+	return &TimeBucketedReservoir{
+		interval: interval,
+		buckets:  buckets,
+	}
+}
+
+var _ Reservoir = &TimeBucketedReservoir{}
+
+// TimeBucketedReservoir is a [Reservoir] that samples one measurement for
+// each time bucket of a fixed length using Algorithm L. This spreads the
+// sampled exemplars evenly over time instead of the arrival of measurements.
+//
+// The first measurement offered after a collection is always sampled. This
+// guarantees every collection cycle that had measurements offered has an
+// exemplar from that cycle.
+type TimeBucketedReservoir struct {
+	reservoir.ConcurrentSafe
+	interval time.Duration
+	buckets  []timeBucket
+}
+
+// Offer accepts the parameters associated with a measurement. The
+// parameters will be stored as an exemplar if the Reservoir decides to
+// sample the measurement.
+//
+// The passed ctx needs to contain any baggage or span that were active
+// when the measurement was made. This information may be used by the
+// Reservoir in making a sampling decision.
+//
+// The time t is the time when the measurement was made. The v and a
+// parameters are the value and dropped (filtered) attributes of the
+// measurement respectively.
+func (r *TimeBucketedReservoir) Offer(ctx context.Context, t time.Time, v Value, a []attribute.KeyValue) {
+	if len(r.buckets) == 0 {
+		return
+	}
+
+	var id int64
+	if r.interval > 0 {
+		id = t.UnixNano() / int64(r.interval)
+	}
+	idx := id % int64(len(r.buckets))
+	if idx < 0 {
+		idx += int64(len(r.buckets))
+	}
+	b := &r.buckets[idx]
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.valid && b.id != id {
+		if id < b.id {
+			// The measurement belongs to a bucket that has been replaced.
+			return
+		}
+		b.nt.reset()
+	}
+	b.id = id
+
+	sampled, _ := b.nt.shouldSample()
+	if sampled {
+		b.store(ctx, t, v, a)
+	}
+}
+
+// Collect returns all the held exemplars.
+//
+// The stored exemplars are preserved after this call, but the sampling state is reset.
+func (r *TimeBucketedReservoir) Collect(dest *[]Exemplar) {
+	*dest = reset(*dest, len(r.buckets), len(r.buckets))
+	var n int
+	for i := range r.buckets {
+		b := &r.buckets[i]
+		b.mu.Lock()
+		if b.exemplar(&(*dest)[n]) {
+			n++
+		}
+		b.nt.reset()
+		b.mu.Unlock()
+	}
+	*dest = (*dest)[:n]
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exemplar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeBucketedReservoir(t *testing.T) {
+	// All measurements of the generic tests are offered at the same time,
+	// they all fall in a single bucket.
+	factory := func(n int) (ReservoirProvider, int) {
+		return TimeBucketedReservoirProvider(time.Second, n), min(n, 1)
+	}
+	t.Run("Int64", ReservoirTest[int64](factory))
+	t.Run("Float64", ReservoirTest[float64](factory))
+}
+
+func TestTimeBucketedReservoirConcurrentSafe(t *testing.T) {
+	factory := func(n int) (ReservoirProvider, int) {
+		return TimeBucketedReservoirProvider(time.Second, n), n
+	}
+	t.Run("Int64", reservoirConcurrentSafeTest[int64](factory))
+	t.Run("Float64", reservoirConcurrentSafeTest[float64](factory))
+}
+
+func TestTimeBucketedReservoirOnePerBucket(t *testing.T) {
+	r := NewTimeBucketedReservoir(time.Second, 3)
+
+	for i := range 3 {
+		bucketStart := staticTime.Add(time.Duration(i) * time.Second)
+		for j := range 10 {
+			ts := bucketStart.Add(time.Duration(j) * 10 * time.Millisecond)
+			r.Offer(t.Context(), ts, NewValue(int64(i)), nil)
+		}
+	}
+
+	var dest []Exemplar
+	r.Collect(&dest)
+	require.Len(t, dest, 3)
+	for _, e := range dest {
+		bucket := e.Value.Int64()
+		start := staticTime.Add(time.Duration(bucket) * time.Second)
+		assert.False(t, e.Time.Before(start), "exemplar time before bucket")
+		assert.True(t, e.Time.Before(start.Add(time.Second)), "exemplar time after bucket")
+	}
+}
+
+func TestTimeBucketedReservoirReplacesOldBuckets(t *testing.T) {
+	r := NewTimeBucketedReservoir(time.Second, 2)
+
+	// Four consecutive buckets, only the two most recent are held.
+	for i := range 4 {
+		ts := staticTime.Add(time.Duration(i) * time.Second)
+		r.Offer(t.Context(), ts, NewValue(int64(i)), nil)
+	}
+	assert.ElementsMatch(t, []float64{2, 3}, collectValues(r))
+
+	// Measurements for replaced buckets are dropped.
+	r.Offer(t.Context(), staticTime, NewValue[int64](10), nil)
+	assert.ElementsMatch(t, []float64{2, 3}, collectValues(r))
+}
+
+func TestTimeBucketedReservoirSampleEachCollection(t *testing.T) {
+	// A single bucket for all measurements.
+	r := NewTimeBucketedReservoir(0, 1)
+
+	ts := staticTime
+	for cycle := range int64(100) {
+		for i := range int64(10) {
+			ts = ts.Add(time.Millisecond)
+			r.Offer(t.Context(), ts, NewValue(cycle*10+i), nil)
+		}
+		var dest []Exemplar
+		r.Collect(&dest)
+		require.Len(t, dest, 1)
+		got := dest[0].Value.Int64()
+		assert.GreaterOrEqual(t, got, cycle*10, "exemplar from previous collection")
+		assert.Less(t, got, (cycle+1)*10, "exemplar from future collection")
+	}
+}
+
+func TestTimeBucketedReservoirNegativeTime(t *testing.T) {
+	r := NewTimeBucketedReservoir(time.Second, 3)
+	r.Offer(t.Context(), time.Unix(-10, 0), NewValue[int64](1), nil)
+	assert.Equal(t, []float64{1}, collectValues(r))
+}
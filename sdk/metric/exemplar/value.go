@@ -57,3 +57,14 @@ func (v Value) Float64() float64 {
 	}
 	return 0
 }
+
+// valueFloat64 returns v as a float64, converting int64 values.
+func valueFloat64(v Value) float64 {
+	switch v.Type() {
+	case Int64ValueType:
+		return float64(v.Int64())
+	case Float64ValueType:
+		return v.Float64()
+	}
+	return 0
+}
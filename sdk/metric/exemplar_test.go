@@ -109,6 +109,25 @@ func TestReservoirFunc(t *testing.T) {
 		},
 	}
 
+	t.Run("MeasurementFilter", func(t *testing.T) {
+		var invoked bool
+		provider := func(attribute.Set) exemplar.Reservoir {
+			invoked = true
+			return nil
+		}
+
+		// The measurement filter takes precedence, even over the drop
+		// optimizations of the context filter.
+		f := reservoirFunc[int64](
+			InstrumentKindObservableGauge,
+			provider,
+			exemplar.AlwaysOffFilter,
+			exemplar.ValueThresholdFilter(1),
+		)
+		_ = f(*attribute.EmptySet())
+		require.True(t, invoked, "ReservoirProvider should be invoked")
+	})
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			var invoked bool
@@ -117,7 +136,7 @@ func TestReservoirFunc(t *testing.T) {
 				return nil
 			}
 
-			f := reservoirFunc[int64](tc.kind, provider, tc.filter)
+			f := reservoirFunc[int64](tc.kind, provider, tc.filter, nil)
 			_ = f(*attribute.EmptySet())
 
 			if tc.expectDrop {
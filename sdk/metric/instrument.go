@@ -223,6 +223,9 @@ func resolveAttributes(configAttrs attribute.Set, rawKVs []attribute.KeyValue) a
 
 type int64Inst struct {
 	measures []aggregate.Measure[int64]
+	// binds holds the function binding the measure at the same index to an
+	// attribute set.
+	binds []aggregate.Bind[int64]
 
 	embedded.Int64Counter
 	embedded.Int64UpDownCounter
@@ -253,6 +256,13 @@ func (i *int64Inst) Enabled(context.Context) bool {
 	return len(i.measures) != 0
 }
 
+// Bind returns a function that records measurements for attrs. The
+// attributes are resolved and filtered once, and the aggregators may skip the
+// lookup of the attributes for each measurement.
+func (i *int64Inst) Bind(attrs attribute.Set) func(context.Context, int64) {
+	return bindMeasures(i.binds, attrs)
+}
+
 func (i *int64Inst) aggregate(
 	ctx context.Context,
 	val int64,
@@ -265,6 +275,9 @@ func (i *int64Inst) aggregate(
 
 type float64Inst struct {
 	measures []aggregate.Measure[float64]
+	// binds holds the function binding the measure at the same index to an
+	// attribute set.
+	binds []aggregate.Bind[float64]
 
 	embedded.Float64Counter
 	embedded.Float64UpDownCounter
@@ -295,12 +308,41 @@ func (i *float64Inst) Enabled(context.Context) bool {
 	return len(i.measures) != 0
 }
 
+// Bind returns a function that records measurements for attrs. The
+// attributes are resolved and filtered once, and the aggregators may skip the
+// lookup of the attributes for each measurement.
+func (i *float64Inst) Bind(attrs attribute.Set) func(context.Context, float64) {
+	return bindMeasures(i.binds, attrs)
+}
+
 func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Set) {
 	for _, in := range i.measures {
 		in(ctx, val, s)
 	}
 }
 
+// bindMeasures returns a function that records measurements for attrs with
+// all the bound aggregate function inputs created by binds. The attrs are
+// normalized the same way as the ones of unbound measurements.
+func bindMeasures[N int64 | float64](binds []aggregate.Bind[N], attrs attribute.Set) func(context.Context, N) {
+	attrs, _ = attrnorm.Set(attrs)
+	switch len(binds) {
+	case 0:
+		return func(context.Context, N) {}
+	case 1:
+		return binds[0](attrs)
+	}
+	bound := make([]aggregate.BoundMeasure[N], len(binds))
+	for i, bind := range binds {
+		bound[i] = bind(attrs)
+	}
+	return func(ctx context.Context, val N) {
+		for _, in := range bound {
+			in(ctx, val)
+		}
+	}
+}
+
 // observableID is a comparable unique identifier of an observable.
 type observableID[N int64 | float64] struct {
 	name        string
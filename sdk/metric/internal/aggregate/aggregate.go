@@ -18,6 +18,13 @@ var now = time.Now
 // Measure receives measurements to be aggregated.
 type Measure[N int64 | float64] func(context.Context, N, attribute.Set)
 
+// BoundMeasure receives measurements to be aggregated for the attribute set
+// it was bound to.
+type BoundMeasure[N int64 | float64] func(context.Context, N)
+
+// Bind returns a BoundMeasure that aggregates measurements for attrs.
+type Bind[N int64 | float64] func(attrs attribute.Set) BoundMeasure[N]
+
 // ComputeAggregation stores the aggregate of measurements into dest and
 // returns the number of aggregate data-points output.
 type ComputeAggregation func(dest *metricdata.Aggregation) int
@@ -47,6 +54,9 @@ type Builder[N int64 | float64] struct {
 	// If AggregationLimit is less than or equal to zero there will not be an
 	// aggregation limit imposed (i.e. unlimited attribute sets).
 	AggregationLimit int
+	// Bind, if not nil, is set to the function used to bind the input of the
+	// returned aggregate function to an attribute set.
+	Bind *Bind[N]
 }
 
 func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
@@ -60,6 +70,21 @@ func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
 type fltrMeasure[N int64 | float64] func(ctx context.Context, value N, lazy lazyFilteredAttributes)
 
 func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
+	return b.bindable(f, func(lazy lazyFilteredAttributes) BoundMeasure[N] {
+		return func(ctx context.Context, n N) { f(ctx, n, lazy) }
+	})
+}
+
+// bindable returns the Measure of f and, if requested, sets b.Bind to a
+// function that uses bind to create a BoundMeasure from the filtered
+// attributes. The filtering is done once when the attributes are bound.
+func (b Builder[N]) bindable(f fltrMeasure[N], bind func(lazyFilteredAttributes) BoundMeasure[N]) Measure[N] {
+	if b.Bind != nil {
+		fltr := b.Filter // Copy to make it immutable after assignment.
+		*b.Bind = func(attrs attribute.Set) BoundMeasure[N] {
+			return bind(newLazyFilteredAttributes(attrs, fltr))
+		}
+	}
 	if b.Filter != nil {
 		fltr := b.Filter // Copy to make it immutable after assignment.
 		return func(ctx context.Context, n N, a attribute.Set) {
@@ -113,10 +138,10 @@ func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		s := newDeltaSum[N](monotonic, b.AggregationLimit, b.resFunc())
-		return b.filter(s.measure), s.collect
+		return b.bindable(s.measure, s.bind), s.collect
 	default:
 		s := newCumulativeSum[N](monotonic, b.AggregationLimit, b.resFunc())
-		return b.filter(s.measure), s.collect
+		return b.bindable(s.measure, s.bind), s.collect
 	}
 }
 
@@ -151,6 +176,25 @@ func (b Builder[N]) ExponentialBucketHistogram(
 	}
 }
 
+// Summary returns a summary aggregate function input and output. The
+// quantiles are estimated using a sketch with a relative error of at most
+// relativeAccuracy that uses at most maxSize bins (unlimited if maxSize is
+// less than or equal to zero).
+func (b Builder[N]) Summary(
+	quantiles []float64,
+	relativeAccuracy float64,
+	maxSize int,
+) (Measure[N], ComputeAggregation) {
+	switch b.Temporality {
+	case metricdata.DeltaTemporality:
+		s := newSummary[N](quantiles, relativeAccuracy, maxSize, false, b.AggregationLimit)
+		return b.filter(s.measure), s.delta
+	default:
+		s := newSummary[N](quantiles, relativeAccuracy, maxSize, true, b.AggregationLimit)
+		return b.filter(s.measure), s.cumulative
+	}
+}
+
 // reset ensures s has capacity and sets it length. If the capacity of s too
 // small, a new slice is returned with the specified capacity and length.
 func reset[T any](s []T, length, capacity int) []T {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -105,6 +106,42 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 	}
 }
 
+func TestBuilderBind(t *testing.T) {
+	t.Run("Int64", testBuilderBind[int64]())
+	t.Run("Float64", testBuilderBind[float64]())
+}
+
+func testBuilderBind[N int64 | float64]() func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		value, attr := N(1), alice
+		run := func(b Builder[N], wantF attribute.Set, wantD []attribute.KeyValue) func(*testing.T) {
+			return func(t *testing.T) {
+				t.Helper()
+
+				var bind Bind[N]
+				b.Bind = &bind
+				var n int
+				_ = b.filter(func(_ context.Context, v N, lazy lazyFilteredAttributes) {
+					n++
+					assert.Equal(t, value, v, "measured incorrect value")
+					assert.Equal(t, wantF, lazy.Set(), "measured incorrect filtered attributes")
+					assert.ElementsMatch(t, wantD, lazy.Dropped(), "measured incorrect dropped attributes")
+				})
+				require.NotNil(t, bind, "bind not set")
+				bound := bind(attr)
+				bound(t.Context(), value)
+				bound(t.Context(), value)
+				assert.Equal(t, 2, n, "bound measurements not recorded")
+			}
+		}
+
+		t.Run("NoFilter", run(Builder[N]{}, attr, nil))
+		t.Run("Filter", run(Builder[N]{Filter: attrFltr}, fltrAlice, []attribute.KeyValue{adminTrue}))
+	}
+}
+
 type arg[N int64 | float64] struct {
 	ctx context.Context
 
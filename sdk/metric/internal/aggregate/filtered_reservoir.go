@@ -31,8 +31,10 @@ type FilteredExemplarReservoir[N int64 | float64] interface {
 
 // filteredExemplarReservoir handles the pre-sampled exemplar of measurements made.
 type filteredExemplarReservoir[N int64 | float64] struct {
-	filter    exemplar.Filter
-	reservoir exemplar.Reservoir
+	filter exemplar.Filter
+	// measFilter is used instead of filter if it is not nil.
+	measFilter exemplar.MeasurementFilter
+	reservoir  exemplar.Reservoir
 	// The exemplar.Reservoir is not required to be concurrent safe, but
 	// implementations can indicate that they are concurrent-safe by embedding
 	// reservoir.ConcurrentSafe in order to improve performance.
@@ -54,8 +56,29 @@ func NewFilteredExemplarReservoir[N int64 | float64](
 	}
 }
 
+// NewMeasurementFilteredExemplarReservoir creates a [FilteredExemplarReservoir]
+// which only offers measurements that are allowed by the measurement filter.
+func NewMeasurementFilteredExemplarReservoir[N int64 | float64](
+	f exemplar.MeasurementFilter,
+	r exemplar.Reservoir,
+) FilteredExemplarReservoir[N] {
+	_, concurrentSafe := r.(reservoir.ConcurrentSafe)
+	return &filteredExemplarReservoir[N]{
+		measFilter:     f,
+		reservoir:      r,
+		concurrentSafe: concurrentSafe,
+	}
+}
+
+func (f *filteredExemplarReservoir[N]) offered(ctx context.Context, val N, lazy lazyFilteredAttributes) bool {
+	if f.measFilter != nil {
+		return f.measFilter(ctx, exemplar.NewValue(val), lazy.orig)
+	}
+	return f.filter(ctx)
+}
+
 func (f *filteredExemplarReservoir[N]) Offer(ctx context.Context, val N, lazy lazyFilteredAttributes) {
-	if f.filter(ctx) {
+	if f.offered(ctx, val, lazy) {
 		// only record the current time if we are sampling this measurement.
 		ts := time.Now()
 		attr := lazy.Dropped()
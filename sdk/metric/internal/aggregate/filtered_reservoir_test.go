@@ -94,6 +94,29 @@ func TestFilteredExemplarReservoir_Offer(t *testing.T) {
 	}
 }
 
+func TestMeasurementFilteredExemplarReservoir_Offer(t *testing.T) {
+	orig := attribute.NewSet(attribute.String("k1", "v1"), attribute.String("k2", "v2"))
+	// Ensure the filter sees the value and all attributes, including the
+	// ones dropped by the attribute filter.
+	filter := func(_ context.Context, val exemplar.Value, attrs attribute.Set) bool {
+		v, ok := attrs.Value("k2")
+		return ok && v.AsString() == "v2" && val.Int64() > 5
+	}
+	attrFilter := func(kv attribute.KeyValue) bool { return kv.Key == "k1" }
+
+	mockRes := &notConcurrentSafeReservoir{}
+	res := NewMeasurementFilteredExemplarReservoir[int64](filter, mockRes)
+	lazy := newLazyFilteredAttributes(orig, attrFilter)
+
+	res.Offer(t.Context(), 5, lazy)
+	assert.False(t, mockRes.offered, "value below threshold offered")
+
+	res.Offer(t.Context(), 10, lazy)
+	assert.True(t, mockRes.offered, "value above threshold not offered")
+	assert.Equal(t, exemplar.NewValue[int64](10), mockRes.ex.Value)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("k2", "v2")}, mockRes.ex.FilteredAttributes)
+}
+
 func TestAggregators_OfferToReservoir(t *testing.T) {
 	DropReservoir[int64](attribute.NewSet()).Offer(t.Context(), 1, lazyFilteredAttributes{})
 
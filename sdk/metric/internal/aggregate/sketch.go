@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate
+
+import (
+	"math"
+)
+
+// sketch is a DDSketch quantile sketch of non-negative values.
+//
+// Values are mapped to logarithmically sized bins so that any quantile
+// returned has a relative error of at most the configured relative accuracy.
+// Sketches with the same relative accuracy can be merged without any loss of
+// accuracy. When the number of bins exceeds the configured maximum, the
+// lowest bins are collapsed, losing accuracy only for the lowest quantiles.
+//
+// See https://www.vldb.org/pvldb/vol12/p2195-masson.pdf
+type sketch struct {
+	// gamma is the ratio between the upper and lower bound of a bin.
+	gamma float64
+	// multiplier is 1/ln(gamma).
+	multiplier float64
+	maxBins    int
+
+	// offset is the bin index of counts[0].
+	offset int32
+	counts []uint64
+
+	zeroCount uint64
+	count     uint64
+	sum       float64
+	min, max  float64
+}
+
+// newSketch returns a sketch with a relative accuracy of alpha, using at most
+// maxBins bins. If maxBins is less than or equal to zero, the number of bins
+// is not limited.
+func newSketch(alpha float64, maxBins int) *sketch {
+	gamma := (1 + alpha) / (1 - alpha)
+	return &sketch{
+		gamma:      gamma,
+		multiplier: 1 / math.Log(gamma),
+		maxBins:    maxBins,
+	}
+}
+
+// index returns the bin index of the positive value v.
+func (s *sketch) index(v float64) int32 {
+	return int32(math.Ceil(math.Log(v) * s.multiplier))
+}
+
+// value returns the value representative of the bin at idx. It has a relative
+// error of at most alpha to all values mapped to the bin.
+func (s *sketch) value(idx int32) float64 {
+	return math.Exp(float64(idx)/s.multiplier) * 2 / (1 + s.gamma)
+}
+
+// record adds v to the sketch. Negative values, NaN and infinities are
+// ignored.
+func (s *sketch) record(v float64) {
+	if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return
+	}
+
+	if s.count == 0 {
+		s.min, s.max = v, v
+	} else {
+		s.min = math.Min(s.min, v)
+		s.max = math.Max(s.max, v)
+	}
+	s.count++
+	s.sum += v
+
+	if v == 0 || v < math.SmallestNonzeroFloat64*s.gamma {
+		s.zeroCount++
+		return
+	}
+	s.add(s.index(v), 1)
+}
+
+// add adds n to the bin at idx.
+func (s *sketch) add(idx int32, n uint64) {
+	if len(s.counts) == 0 {
+		s.offset = idx
+		s.counts = append(s.counts, n)
+		s.collapse()
+		return
+	}
+
+	//nolint:gosec // Length is bounded by maxBins.
+	last := s.offset + int32(len(s.counts)) - 1
+	if s.maxBins > 0 {
+		//nolint:gosec // maxBins is a user provided int32.
+		idx = max(idx, last-int32(s.maxBins)+1) // Would be collapsed.
+	}
+	switch {
+	case idx < s.offset:
+		counts := make([]uint64, int(last-idx)+1)
+		copy(counts[s.offset-idx:], s.counts)
+		s.counts, s.offset = counts, idx
+	case idx > last:
+		for range idx - last {
+			s.counts = append(s.counts, 0)
+		}
+	}
+	s.counts[idx-s.offset] += n
+	s.collapse()
+}
+
+// collapse merges the lowest bins until at most maxBins bins are used.
+func (s *sketch) collapse() {
+	n := len(s.counts) - s.maxBins
+	if s.maxBins <= 0 || n <= 0 {
+		return
+	}
+	var lowest uint64
+	for _, c := range s.counts[:n+1] {
+		lowest += c
+	}
+	s.counts = s.counts[n:]
+	s.counts[0] = lowest
+	//nolint:gosec // n is bounded by the slice length.
+	s.offset += int32(n)
+}
+
+// merge adds all values recorded by o to s. Both sketches need to have the
+// same relative accuracy.
+func (s *sketch) merge(o *sketch) {
+	if o.count == 0 {
+		return
+	}
+	if s.count == 0 {
+		s.min, s.max = o.min, o.max
+	} else {
+		s.min = math.Min(s.min, o.min)
+		s.max = math.Max(s.max, o.max)
+	}
+	s.count += o.count
+	s.sum += o.sum
+	s.zeroCount += o.zeroCount
+	for i, c := range o.counts {
+		if c > 0 {
+			//nolint:gosec // Index is bounded by the slice length.
+			s.add(o.offset+int32(i), c)
+		}
+	}
+}
+
+// quantile returns the estimated value at quantile q, which needs to be in
+// the interval [0, 1]. Zero is returned if the sketch is empty.
+func (s *sketch) quantile(q float64) float64 {
+	if s.count == 0 {
+		return 0
+	}
+	switch {
+	case q <= 0:
+		return s.min
+	case q >= 1:
+		return s.max
+	}
+
+	rank := q * float64(s.count-1)
+	n := float64(s.zeroCount)
+	if n > rank {
+		return 0
+	}
+	for i, c := range s.counts {
+		n += float64(c)
+		if n > rank {
+			//nolint:gosec // Index is bounded by the slice length.
+			v := s.value(s.offset + int32(i))
+			// The extrema are exact, do not return a value outside them.
+			return math.Max(s.min, math.Min(s.max, v))
+		}
+	}
+	return s.max
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSketchEmpty(t *testing.T) {
+	s := newSketch(0.01, 0)
+	assert.Equal(t, uint64(0), s.count)
+	assert.Equal(t, 0.0, s.quantile(0.5))
+}
+
+func TestSketchIgnoresInvalid(t *testing.T) {
+	s := newSketch(0.01, 0)
+	s.record(-1)
+	s.record(math.NaN())
+	s.record(math.Inf(1))
+	assert.Equal(t, uint64(0), s.count)
+}
+
+func TestSketchExtrema(t *testing.T) {
+	s := newSketch(0.01, 0)
+	for _, v := range []float64{3, 0, 7.5, 1} {
+		s.record(v)
+	}
+	assert.Equal(t, uint64(4), s.count)
+	assert.Equal(t, 11.5, s.sum)
+	assert.Equal(t, 0.0, s.quantile(0))
+	assert.Equal(t, 7.5, s.quantile(1))
+	assert.Equal(t, 0.0, s.quantile(0.1), "zero bucket")
+}
+
+// checkAccuracy verifies all quantiles of s are within the relative accuracy
+// alpha of the exact quantiles of the sorted values.
+func checkAccuracy(t *testing.T, s *sketch, sorted []float64, alpha float64) {
+	t.Helper()
+	for _, q := range []float64{0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.95, 0.99, 0.999} {
+		want := sorted[int(q*float64(len(sorted)-1))]
+		got := s.quantile(q)
+		assert.InEpsilonf(t, want, got, alpha, "quantile %v", q)
+	}
+}
+
+func TestSketchRelativeAccuracy(t *testing.T) {
+	const alpha = 0.01
+	rnd := rand.New(rand.NewPCG(1, 2))
+	values := make([]float64, 10_000)
+	for i := range values {
+		// Log-normal distribution spanning many orders of magnitude.
+		values[i] = math.Exp(rnd.NormFloat64() * 3)
+	}
+
+	s := newSketch(alpha, 0)
+	for _, v := range values {
+		s.record(v)
+	}
+	slices.Sort(values)
+	checkAccuracy(t, s, values, alpha)
+}
+
+func TestSketchMerge(t *testing.T) {
+	const alpha = 0.01
+	rnd := rand.New(rand.NewPCG(3, 4))
+	values := make([]float64, 10_000)
+
+	a, b := newSketch(alpha, 0), newSketch(alpha, 0)
+	for i := range values {
+		values[i] = rnd.ExpFloat64() * 100
+		if i%2 == 0 {
+			a.record(values[i])
+		} else {
+			b.record(values[i])
+		}
+	}
+
+	a.merge(b)
+	assert.Equal(t, uint64(len(values)), a.count)
+	slices.Sort(values)
+	assert.Equal(t, values[0], a.quantile(0))
+	assert.Equal(t, values[len(values)-1], a.quantile(1))
+	checkAccuracy(t, a, values, alpha)
+
+	empty := newSketch(alpha, 0)
+	empty.merge(a)
+	assert.Equal(t, a.count, empty.count)
+	assert.Equal(t, a.counts, empty.counts)
+}
+
+func TestSketchCollapse(t *testing.T) {
+	const maxBins = 16
+	s := newSketch(0.01, maxBins)
+	for i := range 1000 {
+		s.record(float64(i + 1))
+	}
+	assert.LessOrEqual(t, len(s.counts), maxBins)
+	assert.Equal(t, uint64(1000), s.count)
+
+	var n uint64
+	for _, c := range s.counts {
+		n += c
+	}
+	assert.Equal(t, s.count, n, "collapsed counts lost")
+	// The highest quantiles are not affected by collapsing.
+	assert.InEpsilon(t, 1000.0, s.quantile(0.9999), 0.01)
+
+	// Recording lower values than the collapsed bins does not grow the sketch.
+	s.record(0.5)
+	assert.LessOrEqual(t, len(s.counts), maxBins)
+}
@@ -5,6 +5,7 @@ package aggregate
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -18,11 +19,16 @@ type sumValue[N int64 | float64] struct {
 	attrs         attribute.Set
 	startTime     time.Time
 	dropExemplars bool
+	// gen is the generation of the sumValueMap the value was stored in.
+	gen uint64
 }
 
 type sumValueMap[N int64 | float64] struct {
 	newRes func(attribute.Set) FilteredExemplarReservoir[N]
 	values limitedSyncMap[*sumValue[N]]
+	// gen is incremented every time values is cleared. It is used to
+	// invalidate the sumValue cached by bound measurements.
+	gen atomic.Uint64
 }
 
 func (s *sumValueMap[N]) measure(
@@ -30,7 +36,12 @@ func (s *sumValueMap[N]) measure(
 	value N,
 	lazy lazyFilteredAttributes,
 ) {
-	sv := s.values.LoadOrStoreAttr(lazy, func(attr attribute.Set) *sumValue[N] {
+	s.record(ctx, s.load(lazy), value, lazy)
+}
+
+// load returns the sumValue for lazy, storing a new one if none exists.
+func (s *sumValueMap[N]) load(lazy lazyFilteredAttributes) *sumValue[N] {
+	return s.values.LoadOrStoreAttr(lazy, func(attr attribute.Set) *sumValue[N] {
 		r := s.newRes(attr)
 		_, isDrop := r.(*dropRes[N])
 		return &sumValue[N]{
@@ -38,8 +49,36 @@ func (s *sumValueMap[N]) measure(
 			attrs:         attr,
 			startTime:     now(),
 			dropExemplars: isDrop,
+			gen:           s.gen.Load(),
 		}
 	})
+}
+
+// loadBound returns the sumValue for lazy. The sumValue cached in c is used
+// if it belongs to the current generation of s, otherwise it is looked up
+// and c is updated.
+func (s *sumValueMap[N]) loadBound(c *atomic.Pointer[sumValue[N]], lazy lazyFilteredAttributes) *sumValue[N] {
+	sv := c.Load()
+	if sv == nil || sv.gen != s.gen.Load() {
+		sv = s.load(lazy)
+		c.Store(sv)
+	}
+	return sv
+}
+
+// clear removes all values from s and invalidates any cached by bound
+// measurements.
+func (s *sumValueMap[N]) clear() {
+	s.values.Clear()
+	s.gen.Add(1)
+}
+
+func (s *sumValueMap[N]) record(
+	ctx context.Context,
+	sv *sumValue[N],
+	value N,
+	lazy lazyFilteredAttributes,
+) {
 	sv.n.add(value)
 	// It is possible for collection to race with measurement and observe the
 	// exemplar in the batch of metrics after the add() for cumulative sums.
@@ -88,6 +127,18 @@ func (s *deltaSum[N]) measure(ctx context.Context, value N, lazy lazyFilteredAtt
 	s.hotColdValMap[hotIdx].measure(ctx, value, lazy)
 }
 
+// bind returns a BoundMeasure for lazy that does not look up its sumValue
+// unless the hot values have been cleared since the last measurement.
+func (s *deltaSum[N]) bind(lazy lazyFilteredAttributes) BoundMeasure[N] {
+	var cache [2]atomic.Pointer[sumValue[N]]
+	return func(ctx context.Context, value N) {
+		hotIdx := s.hcwg.start()
+		defer s.hcwg.done(hotIdx)
+		m := &s.hotColdValMap[hotIdx]
+		m.record(ctx, m.loadBound(&cache[hotIdx], lazy), value, lazy)
+	}
+}
+
 func (s *deltaSum[N]) collect(
 	dest *metricdata.Aggregation, //nolint:gocritic // The pointer is needed for the ComputeAggregation interface
 ) int {
@@ -117,7 +168,7 @@ func (s *deltaSum[N]) collect(
 		i++
 		return true
 	})
-	s.hotColdValMap[readIdx].values.Clear()
+	s.hotColdValMap[readIdx].clear()
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -153,6 +204,16 @@ type cumulativeSum[N int64 | float64] struct {
 	sumValueMap[N]
 }
 
+// bind returns a BoundMeasure for lazy that only looks up its sumValue once.
+// Values are never removed from a cumulativeSum, including the overflow
+// value, so the cached sumValue remains valid.
+func (s *cumulativeSum[N]) bind(lazy lazyFilteredAttributes) BoundMeasure[N] {
+	var cache atomic.Pointer[sumValue[N]]
+	return func(ctx context.Context, value N) {
+		s.record(ctx, s.loadBound(&cache, lazy), value, lazy)
+	}
+}
+
 func (s *cumulativeSum[N]) collect(
 	dest *metricdata.Aggregation, //nolint:gocritic // The pointer is needed for the ComputeAggregation interface
 ) int {
@@ -254,7 +315,7 @@ func (s *precomputedSum[N]) delta(
 		i++
 		return true
 	})
-	s.hotColdValMap[readIdx].values.Clear()
+	s.hotColdValMap[readIdx].clear()
 	s.reported = newReported
 	// The delta collection cycle resets.
 	s.start = t
@@ -294,7 +355,7 @@ func (s *precomputedSum[N]) cumulative(
 		i++
 		return true
 	})
-	s.hotColdValMap[readIdx].values.Clear()
+	s.hotColdValMap[readIdx].clear()
 
 	sData.DataPoints = dPts
 	*dest = sData
@@ -5,6 +5,7 @@ package aggregate
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -576,6 +577,140 @@ func testCumulativePrecomputedSum[N int64 | float64]() func(t *testing.T) {
 	})
 }
 
+func TestSumBind(t *testing.T) {
+	t.Run("Int64/DeltaSum", testDeltaSumBind[int64]())
+	t.Run("Float64/DeltaSum", testDeltaSumBind[float64]())
+	t.Run("Int64/CumulativeSum", testCumulativeSumBind[int64]())
+	t.Run("Float64/CumulativeSum", testCumulativeSumBind[float64]())
+}
+
+func TestSumBindConcurrentSafe(t *testing.T) {
+	t.Run("Int64/DeltaSum", testSumBindConcurrentSafe[int64](metricdata.DeltaTemporality))
+	t.Run("Float64/DeltaSum", testSumBindConcurrentSafe[float64](metricdata.DeltaTemporality))
+	t.Run("Int64/CumulativeSum", testSumBindConcurrentSafe[int64](metricdata.CumulativeTemporality))
+	t.Run("Float64/CumulativeSum", testSumBindConcurrentSafe[float64](metricdata.CumulativeTemporality))
+}
+
+func testSumBindConcurrentSafe[N int64 | float64](temporality metricdata.Temporality) func(t *testing.T) {
+	var bind Bind[N]
+	_, out := Builder[N]{
+		Temporality:      temporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+		Bind:             &bind,
+	}.Sum(false)
+
+	// Bind the attribute sets used by testAggregationConcurrentSafe.
+	bound := make(map[attribute.Distinct]BoundMeasure[N], concurrentNumGoroutines)
+	for i := range concurrentNumGoroutines {
+		attrs := attribute.NewSet(attribute.String(keyUser, strconv.Itoa(i)))
+		bound[attrs.Equivalent()] = bind(attrs)
+	}
+	in := func(ctx context.Context, value N, attrs attribute.Set) {
+		bound[attrs.Equivalent()](ctx, value)
+	}
+	return testAggregationConcurrentSafe[N](in, out, validateSum[N](false))
+}
+
+func testDeltaSumBind[N int64 | float64]() func(t *testing.T) {
+	return func(t *testing.T) {
+		var bind Bind[N]
+		in, out := Builder[N]{
+			Temporality:      metricdata.DeltaTemporality,
+			Filter:           attrFltr,
+			AggregationLimit: 2,
+			Bind:             &bind,
+		}.Sum(true)
+		ctx := t.Context()
+		bound := bind(alice)
+
+		bound(ctx, 1)
+		in(ctx, 2, alice)
+		bound(ctx, 3)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrAlice: 6,
+		}, "bound and unbound measurements not aggregated together")
+
+		// The bound value needs to be reset.
+		bound(ctx, 4)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrAlice: 4,
+		}, "bound value not reset")
+
+		// Both collection cycles need to be skipped without measurements.
+		assertSumValues(t, out, map[attribute.Set]N{}, "unused bound attributes reported")
+		assertSumValues(t, out, map[attribute.Set]N{}, "unused bound attributes reported")
+
+		// The aggregation limit is reached, the bound value overflows.
+		in(ctx, 1, bob)
+		bound(ctx, 5)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrBob:     1,
+			overflowSet: 5,
+		}, "bound value not overflowed")
+
+		// The overflow is reset with the cycle.
+		bound(ctx, 6)
+		in(ctx, 1, bob)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrAlice:   6,
+			overflowSet: 1,
+		}, "bound value overflow not reset")
+	}
+}
+
+func testCumulativeSumBind[N int64 | float64]() func(t *testing.T) {
+	return func(t *testing.T) {
+		var bind Bind[N]
+		in, out := Builder[N]{
+			Temporality:      metricdata.CumulativeTemporality,
+			Filter:           attrFltr,
+			AggregationLimit: 2,
+			Bind:             &bind,
+		}.Sum(true)
+		ctx := t.Context()
+
+		alices := bind(alice)
+		bobs := bind(bob)
+		alices(ctx, 1)
+		in(ctx, 2, alice)
+		alices(ctx, 3)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrAlice: 6,
+		}, "bound and unbound measurements not aggregated together")
+
+		// The aggregation limit is reached, the bound value overflows.
+		bobs(ctx, 4)
+		alices(ctx, 1)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrAlice:   7,
+			overflowSet: 4,
+		}, "bound value not overflowed")
+
+		bobs(ctx, 1)
+		assertSumValues(t, out, map[attribute.Set]N{
+			fltrAlice:   7,
+			overflowSet: 5,
+		}, "bound overflow value not retained")
+	}
+}
+
+// assertSumValues asserts the values of the sum data points returned by out
+// match want. Timestamps are ignored.
+func assertSumValues[N int64 | float64](t *testing.T, out ComputeAggregation, want map[attribute.Set]N, msg string) {
+	t.Helper()
+
+	var agg metricdata.Aggregation
+	out(&agg)
+	s, ok := agg.(metricdata.Sum[N])
+	require.True(t, ok, "not a sum")
+	got := make(map[attribute.Set]N, len(s.DataPoints))
+	for _, dp := range s.DataPoints {
+		got[dp.Attributes] = dp.Value
+	}
+	assert.Equal(t, want, got, msg)
+}
+
 func TestSumConcurrentSafe(t *testing.T) {
 	t.Run("Int64/DeltaSum", testDeltaSumConcurrentSafe[int64]())
 	t.Run("Float64/DeltaSum", testDeltaSumConcurrentSafe[float64]())
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/internal/x"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// summaryDataPoint is a single data point in a summary.
+type summaryDataPoint struct {
+	attrs     attribute.Set
+	sketch    *sketch
+	startTime time.Time
+}
+
+// newSummary returns an Aggregator that summarizes a set of measurements as
+// the quantiles of their distribution. Each summary is scoped by attributes
+// and the aggregation cycle the measurements were made in.
+func newSummary[N int64 | float64](
+	quantiles []float64,
+	relativeAccuracy float64,
+	maxSize int,
+	trackStart bool,
+	limit int,
+) *summary[N] {
+	return &summary[N]{
+		quantiles:        slices.Clone(quantiles),
+		relativeAccuracy: relativeAccuracy,
+		maxSize:          maxSize,
+		trackStart:       trackStart,
+
+		limit:  newLimiter[summaryDataPoint](limit),
+		values: make(map[attribute.Distinct]*summaryDataPoint),
+
+		start: now(),
+	}
+}
+
+// summary summarizes a set of measurements as quantiles estimated by a
+// mergeable sketch.
+type summary[N int64 | float64] struct {
+	quantiles        []float64
+	relativeAccuracy float64
+	maxSize          int
+	// trackStart is true if the start time of each timeseries is tracked.
+	trackStart bool
+
+	limit    limiter[summaryDataPoint]
+	values   map[attribute.Distinct]*summaryDataPoint
+	valuesMu sync.Mutex
+
+	start time.Time
+}
+
+func (s *summary[N]) measure(
+	_ context.Context,
+	value N,
+	lazy lazyFilteredAttributes,
+) {
+	// Summary quantiles cannot be negative. Ignore them, NaN and infinity.
+	v := float64(value)
+	if v < 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+		return
+	}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	distinct := lazy.Distinct()
+	dp, ok := s.values[distinct]
+	if !ok {
+		dp, ok = s.values[overflowSet.Equivalent()]
+		if !ok {
+			var fltrAttr attribute.Set
+			if s.limit.aggLimit > 0 && len(s.values) >= s.limit.aggLimit-1 {
+				fltrAttr = overflowSet
+			} else {
+				fltrAttr = lazy.Set()
+			}
+			dp = &summaryDataPoint{
+				attrs:  fltrAttr,
+				sketch: newSketch(s.relativeAccuracy, s.maxSize),
+			}
+			if s.trackStart {
+				dp.startTime = now()
+			}
+			s.values[fltrAttr.Equivalent()] = dp
+		}
+	}
+	dp.sketch.record(v)
+}
+
+func (s *summary[N]) delta(
+	dest *metricdata.Aggregation, //nolint:gocritic // The pointer is needed for the ComputeAggregation interface
+) int {
+	t := now()
+
+	// If *dest is not a metricdata.Summary, memory reuse is missed. In that
+	// case, use the zero-value sData and hope for better alignment next cycle.
+	sData, _ := (*dest).(metricdata.Summary)
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	n := len(s.values)
+	dPts := reset(sData.DataPoints, n, n)
+
+	var i int
+	for _, val := range s.values {
+		s.set(&dPts[i], val, s.start, t)
+		i++
+	}
+	// Unused attribute sets do not report.
+	clear(s.values)
+
+	s.start = t
+	sData.DataPoints = dPts
+	*dest = sData
+	return n
+}
+
+func (s *summary[N]) cumulative(
+	dest *metricdata.Aggregation, //nolint:gocritic // The pointer is needed for the ComputeAggregation interface
+) int {
+	t := now()
+
+	// If *dest is not a metricdata.Summary, memory reuse is missed. In that
+	// case, use the zero-value sData and hope for better alignment next cycle.
+	sData, _ := (*dest).(metricdata.Summary)
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	n := len(s.values)
+	dPts := reset(sData.DataPoints, n, n)
+
+	perSeriesStartTimeEnabled := x.PerSeriesStartTimestamps.Enabled()
+
+	var i int
+	for _, val := range s.values {
+		startTime := s.start
+		if perSeriesStartTimeEnabled {
+			startTime = val.startTime
+		}
+		s.set(&dPts[i], val, startTime, t)
+		i++
+		// TODO (#3006): This will use an unbounded amount of memory if there
+		// are unbounded number of attribute sets being aggregated. Attribute
+		// sets that become "stale" need to be forgotten so this will not
+		// overload the system.
+	}
+
+	sData.DataPoints = dPts
+	*dest = sData
+	return n
+}
+
+// set sets the fields of dest to the current value of val.
+func (s *summary[N]) set(dest *metricdata.SummaryDataPoint, val *summaryDataPoint, start, t time.Time) {
+	dest.Attributes = val.attrs
+	dest.StartTime = start
+	dest.Time = t
+	dest.Count = val.sketch.count
+	dest.Sum = val.sketch.sum
+
+	dest.QuantileValues = reset(dest.QuantileValues, len(s.quantiles), len(s.quantiles))
+	for j, q := range s.quantiles {
+		dest.QuantileValues[j] = metricdata.QuantileValue{
+			Quantile: q,
+			Value:    val.sketch.quantile(q),
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var extremaQuantiles = []float64{0, 1}
+
+func sPt(a attribute.Set, count uint64, sum, minV, maxV float64, start, end int64) metricdata.SummaryDataPoint {
+	return metricdata.SummaryDataPoint{
+		Attributes: a,
+		StartTime:  y2kPlus(start),
+		Time:       y2kPlus(end),
+		Count:      count,
+		Sum:        sum,
+		QuantileValues: []metricdata.QuantileValue{
+			{Quantile: 0, Value: minV},
+			{Quantile: 1, Value: maxV},
+		},
+	}
+}
+
+func TestSummary(t *testing.T) {
+	c := new(clock)
+	t.Cleanup(c.Register())
+
+	t.Run("Int64/Delta", testDeltaSummary[int64]())
+	c.Reset()
+	t.Run("Float64/Delta", testDeltaSummary[float64]())
+	c.Reset()
+
+	t.Run("Int64/Cumulative", func(t *testing.T) {
+		t.Setenv("OTEL_GO_X_PER_SERIES_START_TIMESTAMPS", "false")
+		testCumulativeSummary[int64]()(t)
+	})
+	c.Reset()
+	t.Run("Float64/Cumulative", func(t *testing.T) {
+		t.Setenv("OTEL_GO_X_PER_SERIES_START_TIMESTAMPS", "false")
+		testCumulativeSummary[float64]()(t)
+	})
+}
+
+func testDeltaSummary[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality:      metricdata.DeltaTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.Summary(extremaQuantiles, 0.01, 128)
+	ctx := context.Background()
+	return test[N](in, out, []teststep[N]{
+		{
+			input: []arg[N]{},
+			expect: output{
+				n:   0,
+				agg: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{}},
+			},
+		},
+		{
+			input: []arg[N]{
+				{ctx, 2, alice},
+				{ctx, 10, bob},
+				{ctx, 4, alice},
+				{ctx, -1, alice}, // Negative values are ignored.
+				{ctx, 8, bob},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{
+					sPt(fltrAlice, 2, 6, 2, 4, 1, 2),
+					sPt(fltrBob, 2, 18, 8, 10, 1, 2),
+				}},
+			},
+		},
+		{
+			input: []arg[N]{
+				{ctx, 1, alice},
+			},
+			expect: output{
+				n: 1,
+				agg: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{
+					sPt(fltrAlice, 1, 1, 1, 1, 2, 3),
+				}},
+			},
+		},
+		{
+			input: []arg[N]{
+				{ctx, 1, alice},
+				{ctx, 1, bob},
+				// These will exceed cardinality limit.
+				{ctx, 1, carol},
+				{ctx, 1, dave},
+			},
+			expect: output{
+				n: 3,
+				agg: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{
+					sPt(fltrAlice, 1, 1, 1, 1, 3, 4),
+					sPt(fltrBob, 1, 1, 1, 1, 3, 4),
+					sPt(overflowSet, 2, 2, 1, 1, 3, 4),
+				}},
+			},
+		},
+	})
+}
+
+func testCumulativeSummary[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality:      metricdata.CumulativeTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.Summary(extremaQuantiles, 0.01, 128)
+	ctx := context.Background()
+	return test[N](in, out, []teststep[N]{
+		{
+			input: []arg[N]{
+				{ctx, 2, alice},
+				{ctx, 10, bob},
+				{ctx, 4, alice},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{
+					sPt(fltrAlice, 2, 6, 2, 4, 0, 3),
+					sPt(fltrBob, 1, 10, 10, 10, 0, 3),
+				}},
+			},
+		},
+		{
+			input: []arg[N]{
+				{ctx, 1, alice},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{
+					sPt(fltrAlice, 3, 7, 1, 4, 0, 4),
+					sPt(fltrBob, 1, 10, 10, 10, 0, 4),
+				}},
+			},
+		},
+	})
+}
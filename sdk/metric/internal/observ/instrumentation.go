@@ -7,6 +7,7 @@ package observ
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk"
 	"go.opentelemetry.io/otel/sdk/internal/x"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
@@ -21,6 +23,10 @@ import (
 )
 
 const (
+	// CallbackFailedName is the name of the metric counting the callbacks of
+	// observable instruments that did not complete during a collection.
+	CallbackFailedName = "otel.sdk.metric_reader.callback.failed"
+
 	// ScopeName is the unique name of the meter used for instrumentation.
 	ScopeName = "go.opentelemetry.io/otel/sdk/metric/internal/observ"
 
@@ -67,7 +73,8 @@ func ComponentName(componentType string, id int64) string {
 
 // Instrumentation is experimental instrumentation for the metric reader.
 type Instrumentation struct {
-	colDuration metric.Float64Histogram
+	colDuration    metric.Float64Histogram
+	callbackFailed metric.Int64Counter
 
 	attrs  []attribute.KeyValue
 	recOpt metric.RecordOption
@@ -108,6 +115,19 @@ func NewInstrumentation(componentType string, id int64) (*Instrumentation, error
 	}
 	i.colDuration = colDuration.Inst()
 
+	var e error
+	i.callbackFailed, e = meter.Int64Counter(
+		CallbackFailedName,
+		metric.WithDescription(
+			"The number of callbacks of observable instruments that timed out or were skipped during a collection.",
+		),
+		metric.WithUnit("{callback}"),
+	)
+	if e != nil {
+		i.callbackFailed = noop.Int64Counter{}
+		err = errors.Join(err, fmt.Errorf("failed to create callback failed metric: %w", e))
+	}
+
 	return i, err
 }
 
@@ -146,6 +166,17 @@ func (e CollectOp) End(err error) {
 	e.inst.colDuration.Record(e.ctx, d, *recOpt...)
 }
 
+// CallbackFailed records that a callback did not complete during a
+// collection. The errorType describes why, e.g. "timeout".
+func (i *Instrumentation) CallbackFailed(ctx context.Context, errorType string) {
+	attrs := get[attribute.KeyValue](measureAttrsPool)
+	defer put(measureAttrsPool, attrs)
+	*attrs = append(*attrs, i.attrs...)
+	*attrs = append(*attrs, semconv.ErrorTypeKey.String(errorType))
+
+	i.callbackFailed.Add(ctx, 1, metric.WithAttributeSet(attribute.NewSet(*attrs...)))
+}
+
 // recordOption returns a RecordOption with attributes representing the
 // outcome of the collection being recorded.
 //
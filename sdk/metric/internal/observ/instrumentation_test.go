@@ -52,6 +52,10 @@ func (m *errMeter) Float64Histogram(string, ...mapi.Float64HistogramOption) (map
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Counter(string, ...mapi.Int64CounterOption) (mapi.Int64Counter, error) {
+	return nil, m.err
+}
+
 func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 	orig := otel.GetMeterProvider()
 	t.Cleanup(func() { otel.SetMeterProvider(orig) })
@@ -64,6 +68,7 @@ func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors should be joined")
 
 	assert.ErrorContains(t, err, "collection duration metric")
+	assert.ErrorContains(t, err, "callback failed metric")
 }
 
 func TestNewInstrumentationObservabilityDisabled(t *testing.T) {
@@ -157,6 +162,37 @@ func TestInstrumentationCollectMetricsError(t *testing.T) {
 	assertCollectionMetrics(t, collect(), wantErr)
 }
 
+func TestInstrumentationCallbackFailed(t *testing.T) {
+	inst, collect := setup(t)
+
+	inst.CallbackFailed(t.Context(), "timeout")
+	inst.CallbackFailed(t.Context(), "timeout")
+	inst.CallbackFailed(t.Context(), "skipped")
+
+	got := collect()
+	assert.Equal(t, Scope, got.Scope, "unexpected scope")
+	require.Len(t, got.Metrics, 1, "expected 1 metric (callback failed)")
+
+	attrs := func(errorType string) attribute.Set {
+		return attribute.NewSet(append(baseAttrs(nil), semconv.ErrorTypeKey.String(errorType))...)
+	}
+	want := metricdata.Metrics{
+		Name: observ.CallbackFailedName,
+		Description: "The number of callbacks of observable instruments that timed out or were skipped " +
+			"during a collection.",
+		Unit: "{callback}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: attrs("timeout"), Value: 2},
+				{Attributes: attrs("skipped"), Value: 1},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, got.Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
 func TestComponentName(t *testing.T) {
 	tests := []struct {
 		componentType string
@@ -79,6 +79,12 @@ func (mr *ManualReader) register(p sdkProducer) {
 	}
 }
 
+// instrumentation returns the self-observability instrumentation of mr,
+// nil if it is not enabled.
+func (mr *ManualReader) instrumentation() *observ.Instrumentation {
+	return mr.inst
+}
+
 // temporality reports the Temporality for the instrument kind provided.
 func (mr *ManualReader) temporality(kind InstrumentKind) metricdata.Temporality {
 	return mr.temporalitySelector(kind)
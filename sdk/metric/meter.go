@@ -147,7 +147,7 @@ func (m *meter) int64ObservableInstrument(
 		for _, insert := range m.int64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, allowedKeys, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, allowedKeys, insert.readerDefaultAggregation(id.Kind))
 			if err != nil {
 				return inst, err
 			}
@@ -163,9 +163,10 @@ func (m *meter) int64ObservableInstrument(
 			// is not part of the pipeline.
 			insert.pipeline.addInt64Measure(inst.observableID, in)
 			for _, cback := range callbacks {
-				inst := int64Observer{measures: in}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(func(ctx context.Context, guard *callbackGuard) error {
+					return fn(ctx, int64Observer{measures: in, guard: guard})
+				})
 			}
 		}
 		return inst, validateInstrumentName(id.Name)
@@ -330,7 +331,7 @@ func (m *meter) float64ObservableInstrument(
 		for _, insert := range m.float64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, allowedKeys, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, allowedKeys, insert.readerDefaultAggregation(id.Kind))
 			if err != nil {
 				return inst, err
 			}
@@ -346,9 +347,10 @@ func (m *meter) float64ObservableInstrument(
 			// is not part of the pipeline.
 			insert.pipeline.addFloat64Measure(inst.observableID, in)
 			for _, cback := range callbacks {
-				inst := float64Observer{measures: in}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(func(ctx context.Context, guard *callbackGuard) error {
+					return fn(ctx, float64Observer{measures: in, guard: guard})
+				})
 			}
 		}
 		return inst, validateInstrumentName(id.Name)
@@ -534,7 +536,11 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 		}
 
 		// Some or all instruments were valid.
-		cBack := func(ctx context.Context) error { return f(ctx, reg) }
+		cBack := func(ctx context.Context, guard *callbackGuard) error {
+			r := reg
+			r.guard = guard
+			return f(ctx, r)
+		}
 		unregs[ix] = pipe.addMultiCallback(cBack)
 	}
 
@@ -547,6 +553,7 @@ type observer struct {
 	pipe    *pipeline
 	float64 map[observableID[float64]]struct{}
 	int64   map[observableID[int64]]struct{}
+	guard   *callbackGuard
 }
 
 func newObserver(p *pipeline) observer {
@@ -571,6 +578,11 @@ var (
 )
 
 func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...metric.ObserveOption) {
+	if !r.guard.begin() {
+		return
+	}
+	defer r.guard.end()
+
 	var oImpl float64Observable
 	switch conv := o.(type) {
 	case float64Observable:
@@ -604,6 +616,11 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 }
 
 func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric.ObserveOption) {
+	if !r.guard.begin() {
+		return
+	}
+	defer r.guard.end()
+
 	var oImpl int64Observable
 	switch conv := o.(type) {
 	case int64Observable:
@@ -649,7 +666,7 @@ func (p int64InstProvider) aggs(
 	kind InstrumentKind,
 	name, desc, u string,
 	allowedKeys []attribute.Key,
-) ([]aggregate.Measure[int64], error) {
+) ([]aggregate.Measure[int64], []aggregate.Bind[int64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
@@ -664,7 +681,7 @@ func (p int64InstProvider) histogramAggs(
 	name string,
 	cfg metric.Int64HistogramConfig,
 	allowedKeys []attribute.Key,
-) ([]aggregate.Measure[int64], error) {
+) ([]aggregate.Measure[int64], []aggregate.Bind[int64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -678,8 +695,8 @@ func (p int64InstProvider) histogramAggs(
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
-	measures, err := p.int64Resolver.HistogramAggregators(inst, allowedKeys, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, binds, err := p.int64Resolver.HistogramAggregators(inst, allowedKeys, boundaries)
+	return measures, binds, errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
@@ -694,8 +711,8 @@ func (p int64InstProvider) lookup(
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u, allowedKeys)
-		return &int64Inst{measures: aggs}, err
+		aggs, binds, err := p.aggs(kind, name, desc, u, allowedKeys)
+		return &int64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
@@ -711,8 +728,8 @@ func (p int64InstProvider) lookupHistogram(
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg, allowedKeys)
-		return &int64Inst{measures: aggs}, err
+		aggs, binds, err := p.histogramAggs(name, cfg, allowedKeys)
+		return &int64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
@@ -723,7 +740,7 @@ func (p float64InstProvider) aggs(
 	kind InstrumentKind,
 	name, desc, u string,
 	allowedKeys []attribute.Key,
-) ([]aggregate.Measure[float64], error) {
+) ([]aggregate.Measure[float64], []aggregate.Bind[float64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
@@ -738,7 +755,7 @@ func (p float64InstProvider) histogramAggs(
 	name string,
 	cfg metric.Float64HistogramConfig,
 	allowedKeys []attribute.Key,
-) ([]aggregate.Measure[float64], error) {
+) ([]aggregate.Measure[float64], []aggregate.Bind[float64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -752,8 +769,8 @@ func (p float64InstProvider) histogramAggs(
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
-	measures, err := p.float64Resolver.HistogramAggregators(inst, allowedKeys, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, binds, err := p.float64Resolver.HistogramAggregators(inst, allowedKeys, boundaries)
+	return measures, binds, errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
@@ -768,8 +785,8 @@ func (p float64InstProvider) lookup(
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u, allowedKeys)
-		return &float64Inst{measures: aggs}, err
+		aggs, binds, err := p.aggs(kind, name, desc, u, allowedKeys)
+		return &float64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
@@ -785,17 +802,23 @@ func (p float64InstProvider) lookupHistogram(
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg, allowedKeys)
-		return &float64Inst{measures: aggs}, err
+		aggs, binds, err := p.histogramAggs(name, cfg, allowedKeys)
+		return &float64Inst{measures: aggs, binds: binds}, err
 	})
 }
 
 type int64Observer struct {
 	embedded.Int64Observer
 	measures[int64]
+	guard *callbackGuard
 }
 
 func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
+	if !o.guard.begin() {
+		return
+	}
+	defer o.guard.end()
+
 	c := metric.NewObserveConfig(opts)
 	rawKVs := extractRawKVs(opts)
 	o.observe(val, resolveAttributes(c.Attributes(), rawKVs))
@@ -804,9 +827,15 @@ func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
 type float64Observer struct {
 	embedded.Float64Observer
 	measures[float64]
+	guard *callbackGuard
 }
 
 func (o float64Observer) Observe(val float64, opts ...metric.ObserveOption) {
+	if !o.guard.begin() {
+		return
+	}
+	defer o.guard.end()
+
 	c := metric.NewObserveConfig(opts)
 	rawKVs := extractRawKVs(opts)
 	o.observe(val, resolveAttributes(c.Attributes(), rawKVs))
@@ -121,6 +121,44 @@ func TestMapDeduplication(t *testing.T) {
 	assert.Equal(t, attribute.NewSet(dedup), sum.DataPoints[0].Attributes)
 }
 
+func TestBoundMapDeduplication(t *testing.T) {
+	dup := attribute.NewSet(attribute.Map(
+		"map",
+		attribute.String("key", "first"),
+		attribute.String("key", "second"),
+	))
+	dedup := attribute.NewSet(attribute.Map("map", attribute.String("key", "second")))
+
+	reader := NewManualReader()
+	mp := NewMeterProvider(WithReader(reader))
+	meter := mp.Meter("TestBoundMapDeduplication")
+	ic, err := meter.Int64Counter("int64.counter")
+	require.NoError(t, err)
+	fc, err := meter.Float64Counter("float64.counter")
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	x.BindInt64Counter(ic, dup).Add(ctx, 1)
+	ic.Add(ctx, 2, metric.WithAttributeSet(dup))
+	x.BindFloat64Counter(fc, dup).Add(ctx, 1)
+	fc.Add(ctx, 2, metric.WithAttributeSet(dup))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{{Attributes: dedup, Value: 3}},
+	}, rm.ScopeMetrics[0].Metrics[0].Data, metricdatatest.IgnoreTimestamp())
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[float64]{{Attributes: dedup, Value: 3}},
+	}, rm.ScopeMetrics[0].Metrics[1].Data, metricdatatest.IgnoreTimestamp())
+}
+
 var emptyCallback metric.Callback = func(context.Context, metric.Observer) error { return nil }
 
 // A Meter Should be able register Callbacks Concurrently.
@@ -2661,6 +2699,49 @@ func TestExemplarFilter(t *testing.T) {
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
 }
 
+func TestExemplarMeasurementFilter(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithExemplarMeasurementFilter(exemplar.AnyOf(
+			exemplar.ValueThresholdFilter(100),
+			exemplar.AttributeFilter(attribute.Bool("error", true)),
+		)),
+		WithView(NewView(Instrument{Name: "hist"}, Stream{
+			Aggregation: AggregationExplicitBucketHistogram{NoMinMax: true},
+			// The filter needs to see attributes dropped by a View.
+			AttributeFilter: attribute.NewDenyKeysFilter("error"),
+			ExemplarReservoirProviderSelector: func(Aggregation) exemplar.ReservoirProvider {
+				return exemplar.MaxValueReservoirProvider(3)
+			},
+		})),
+	)
+
+	hist, err := mp.Meter("scope").Float64Histogram("hist")
+	require.NoError(t, err)
+	ctx := t.Context()
+	hist.Record(ctx, 1)
+	hist.Record(ctx, 2, metric.WithAttributes(attribute.Bool("error", true)))
+	hist.Record(ctx, 150)
+	hist.Record(ctx, 50)
+	hist.Record(ctx, 120)
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &got))
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+	data, ok := got.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+	require.True(t, ok, "unexpected data type")
+	require.Len(t, data.DataPoints, 1)
+
+	var values []float64
+	for _, e := range data.DataPoints[0].Exemplars {
+		values = append(values, e.Value)
+	}
+	// The measurements of 1 and 50 are not offered.
+	assert.ElementsMatch(t, []float64{2, 120, 150}, values)
+}
+
 func TestMeterDefaultAttributes(t *testing.T) {
 	k1 := attribute.Key("k1")
 	k2 := attribute.Key("k2")
@@ -3054,3 +3135,197 @@ func TestMeterDefaultAttributes_FilterAll(t *testing.T) {
 	}
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
 }
+
+func TestSummaryAggregation(t *testing.T) {
+	for _, temp := range []metricdata.Temporality{
+		metricdata.CumulativeTemporality,
+		metricdata.DeltaTemporality,
+	} {
+		t.Run(temp.String(), func(t *testing.T) {
+			rdr := NewManualReader(WithTemporalitySelector(func(InstrumentKind) metricdata.Temporality {
+				return temp
+			}))
+			view := NewView(Instrument{Name: "latency"}, Stream{
+				Aggregation: AggregationSummary{Quantiles: []float64{0, 0.5, 1}},
+			})
+			mp := NewMeterProvider(WithReader(rdr), WithView(view))
+			hist, err := mp.Meter("TestSummaryAggregation").Float64Histogram("latency")
+			require.NoError(t, err)
+
+			ctx := t.Context()
+			for i := range 101 {
+				hist.Record(ctx, float64(i+100))
+			}
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, rdr.Collect(ctx, &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+			s, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Summary)
+			require.Truef(t, ok, "unexpected data type: %T", rm.ScopeMetrics[0].Metrics[0].Data)
+			require.Len(t, s.DataPoints, 1)
+
+			dp := s.DataPoints[0]
+			assert.Equal(t, uint64(101), dp.Count)
+			assert.Equal(t, 15150.0, dp.Sum)
+			require.Len(t, dp.QuantileValues, 3)
+			assert.Equal(t, metricdata.QuantileValue{Quantile: 0, Value: 100}, dp.QuantileValues[0])
+			assert.Equal(t, 0.5, dp.QuantileValues[1].Quantile)
+			assert.InEpsilon(t, 150, dp.QuantileValues[1].Value, 0.01)
+			assert.Equal(t, metricdata.QuantileValue{Quantile: 1, Value: 200}, dp.QuantileValues[2])
+		})
+	}
+}
+
+func TestBoundInstruments(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("a", "1"), attribute.String("b", "2"))
+	fltrAttrs := attribute.NewSet(attribute.String("a", "1"))
+
+	deltaRdr := NewManualReader(WithTemporalitySelector(func(InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	}))
+	cumulativeRdr := NewManualReader()
+	view := NewView(Instrument{Name: "*"}, Stream{AttributeFilter: attribute.NewAllowKeysFilter("a")})
+	mp := NewMeterProvider(WithReader(deltaRdr), WithReader(cumulativeRdr), WithView(view))
+	m := mp.Meter("TestBoundInstruments")
+
+	ic, err := m.Int64Counter("int64.counter")
+	require.NoError(t, err)
+	iu, err := m.Int64UpDownCounter("int64.updowncounter")
+	require.NoError(t, err)
+	ih, err := m.Int64Histogram("int64.histogram", metric.WithExplicitBucketBoundaries(5))
+	require.NoError(t, err)
+	ig, err := m.Int64Gauge("int64.gauge")
+	require.NoError(t, err)
+	fc, err := m.Float64Counter("float64.counter")
+	require.NoError(t, err)
+	fu, err := m.Float64UpDownCounter("float64.updowncounter")
+	require.NoError(t, err)
+	fh, err := m.Float64Histogram("float64.histogram", metric.WithExplicitBucketBoundaries(5))
+	require.NoError(t, err)
+	fg, err := m.Float64Gauge("float64.gauge")
+	require.NoError(t, err)
+
+	boundIC := x.BindInt64Counter(ic, attrs)
+	boundIU := x.BindInt64UpDownCounter(iu, attrs)
+	boundIH := x.BindInt64Histogram(ih, attrs)
+	boundIG := x.BindInt64Gauge(ig, attrs)
+	boundFC := x.BindFloat64Counter(fc, attrs)
+	boundFU := x.BindFloat64UpDownCounter(fu, attrs)
+	boundFH := x.BindFloat64Histogram(fh, attrs)
+	boundFG := x.BindFloat64Gauge(fg, attrs)
+
+	record := func(ctx context.Context) {
+		boundIC.Add(ctx, 1)
+		ic.Add(ctx, 2, metric.WithAttributeSet(attrs))
+		boundIU.Add(ctx, -1)
+		boundIH.Record(ctx, 3)
+		boundIG.Record(ctx, 4)
+		boundFC.Add(ctx, 1)
+		fc.Add(ctx, 2, metric.WithAttributeSet(attrs))
+		boundFU.Add(ctx, -1)
+		boundFH.Record(ctx, 3)
+		boundFG.Record(ctx, 4)
+	}
+
+	want := func(temporality metricdata.Temporality, cycle int64) []metricdata.Metrics {
+		n := int64(1)
+		if temporality == metricdata.CumulativeTemporality {
+			n = cycle
+		}
+		hist := func() []metricdata.HistogramDataPoint[int64] {
+			return []metricdata.HistogramDataPoint[int64]{{
+				Attributes:   fltrAttrs,
+				Bounds:       []float64{5},
+				BucketCounts: []uint64{uint64(n), 0},
+				Count:        uint64(n),
+				Min:          metricdata.NewExtrema[int64](3),
+				Max:          metricdata.NewExtrema[int64](3),
+				Sum:          3 * n,
+			}}
+		}
+		fHist := func() []metricdata.HistogramDataPoint[float64] {
+			return []metricdata.HistogramDataPoint[float64]{{
+				Attributes:   fltrAttrs,
+				Bounds:       []float64{5},
+				BucketCounts: []uint64{uint64(n), 0},
+				Count:        uint64(n),
+				Min:          metricdata.NewExtrema[float64](3),
+				Max:          metricdata.NewExtrema[float64](3),
+				Sum:          3 * float64(n),
+			}}
+		}
+		return []metricdata.Metrics{
+			{
+				Name: "int64.counter",
+				Data: metricdata.Sum[int64]{
+					Temporality: temporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Attributes: fltrAttrs, Value: 3 * n}},
+				},
+			},
+			{
+				Name: "int64.updowncounter",
+				Data: metricdata.Sum[int64]{
+					Temporality: temporality,
+					DataPoints:  []metricdata.DataPoint[int64]{{Attributes: fltrAttrs, Value: -n}},
+				},
+			},
+			{
+				Name: "int64.histogram",
+				Data: metricdata.Histogram[int64]{Temporality: temporality, DataPoints: hist()},
+			},
+			{
+				Name: "int64.gauge",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: fltrAttrs, Value: 4}},
+				},
+			},
+			{
+				Name: "float64.counter",
+				Data: metricdata.Sum[float64]{
+					Temporality: temporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[float64]{{Attributes: fltrAttrs, Value: 3 * float64(n)}},
+				},
+			},
+			{
+				Name: "float64.updowncounter",
+				Data: metricdata.Sum[float64]{
+					Temporality: temporality,
+					DataPoints:  []metricdata.DataPoint[float64]{{Attributes: fltrAttrs, Value: -float64(n)}},
+				},
+			},
+			{
+				Name: "float64.histogram",
+				Data: metricdata.Histogram[float64]{Temporality: temporality, DataPoints: fHist()},
+			},
+			{
+				Name: "float64.gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{Attributes: fltrAttrs, Value: 4}},
+				},
+			},
+		}
+	}
+
+	ctx := t.Context()
+	for cycle := int64(1); cycle <= 2; cycle++ {
+		record(ctx)
+		for _, rdr := range []struct {
+			reader      Reader
+			temporality metricdata.Temporality
+		}{
+			{deltaRdr, metricdata.DeltaTemporality},
+			{cumulativeRdr, metricdata.CumulativeTemporality},
+		} {
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, rdr.reader.Collect(ctx, &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+				Scope:   instrumentation.Scope{Name: "TestBoundInstruments"},
+				Metrics: want(rdr.temporality, cycle),
+			}, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+		}
+	}
+}
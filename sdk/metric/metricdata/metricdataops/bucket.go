@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops
+
+import (
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// DownscaleExponentialBucket returns b with its resolution reduced by
+// scaleDelta, merging the counts of every 2^scaleDelta adjacent buckets.
+//
+// If scaleDelta is less than or equal to zero, a copy of b is returned. The
+// returned bucket never shares memory with b.
+func DownscaleExponentialBucket(b metricdata.ExponentialBucket, scaleDelta int32) metricdata.ExponentialBucket {
+	if scaleDelta <= 0 {
+		return cloneBucket(b)
+	}
+	offset := b.Offset >> scaleDelta
+	if len(b.Counts) == 0 {
+		return metricdata.ExponentialBucket{Offset: offset}
+	}
+	// Compute the index of the last bucket in 64 bits so an offset close to
+	// math.MaxInt32 does not overflow.
+	last := int32((int64(b.Offset) + int64(len(b.Counts)) - 1) >> scaleDelta) //nolint:gosec // Shifted back into range.
+	counts := make([]uint64, last-offset+1)
+	for i, n := range b.Counts {
+		idx := int32((int64(b.Offset)+int64(i))>>scaleDelta) - offset //nolint:gosec // Shifted back into range.
+		counts[idx] += n
+	}
+	return metricdata.ExponentialBucket{Offset: offset, Counts: counts}
+}
+
+func cloneBucket(b metricdata.ExponentialBucket) metricdata.ExponentialBucket {
+	return metricdata.ExponentialBucket{Offset: b.Offset, Counts: slices.Clone(b.Counts)}
+}
+
+// addBuckets returns the sum of a and b. Both buckets need to be of the same
+// scale.
+func addBuckets(a, b metricdata.ExponentialBucket) metricdata.ExponentialBucket {
+	if len(a.Counts) == 0 {
+		return cloneBucket(b)
+	}
+	if len(b.Counts) == 0 {
+		return cloneBucket(a)
+	}
+	offset := min(a.Offset, b.Offset)
+	//nolint:gosec // Length is bounded by the slice length.
+	end := max(a.Offset+int32(len(a.Counts)), b.Offset+int32(len(b.Counts)))
+	counts := make([]uint64, end-offset)
+	for i, n := range a.Counts {
+		counts[int(a.Offset-offset)+i] += n
+	}
+	for i, n := range b.Counts {
+		counts[int(b.Offset-offset)+i] += n
+	}
+	return metricdata.ExponentialBucket{Offset: offset, Counts: counts}
+}
+
+// diffBuckets returns the difference of a and b. Both buckets need to be of
+// the same scale. An error wrapping [ErrNegativeCount] is returned if any
+// bucket count of b is greater than the same bucket count of a.
+func diffBuckets(a, b metricdata.ExponentialBucket) (metricdata.ExponentialBucket, error) {
+	out := cloneBucket(a)
+	for i, n := range b.Counts {
+		if n == 0 {
+			continue
+		}
+		//nolint:gosec // Index is bounded by the slice length.
+		idx := int(b.Offset+int32(i)) - int(a.Offset)
+		if idx < 0 || idx >= len(out.Counts) || out.Counts[idx] < n {
+			//nolint:gosec // Index is bounded by the slice length.
+			return metricdata.ExponentialBucket{}, fmt.Errorf("%w: bucket %d", ErrNegativeCount, b.Offset+int32(i))
+		}
+		out.Counts[idx] -= n
+	}
+	return out, nil
+}
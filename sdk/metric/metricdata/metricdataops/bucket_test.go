@@ -0,0 +1,239 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestDownscaleExponentialBucket(t *testing.T) {
+	tests := []struct {
+		name       string
+		bucket     metricdata.ExponentialBucket
+		scaleDelta int32
+		want       metricdata.ExponentialBucket
+	}{
+		{
+			name:       "Empty bucket",
+			bucket:     metricdata.ExponentialBucket{},
+			scaleDelta: 3,
+			want:       metricdata.ExponentialBucket{},
+		},
+		{
+			name: "1 size bucket",
+			bucket: metricdata.ExponentialBucket{
+				Offset: 50,
+				Counts: []uint64{7},
+			},
+			scaleDelta: 4,
+			want: metricdata.ExponentialBucket{
+				Offset: 3,
+				Counts: []uint64{7},
+			},
+		},
+		{
+			name: "zero scale delta",
+			bucket: metricdata.ExponentialBucket{
+				Offset: 50,
+				Counts: []uint64{7, 5},
+			},
+			scaleDelta: 0,
+			want: metricdata.ExponentialBucket{
+				Offset: 50,
+				Counts: []uint64{7, 5},
+			},
+		},
+		{
+			name: "aligned bucket scale 1",
+			bucket: metricdata.ExponentialBucket{
+				Offset: 0,
+				Counts: []uint64{1, 2, 3, 4, 5, 6},
+			},
+			scaleDelta: 1,
+			want: metricdata.ExponentialBucket{
+				Offset: 0,
+				Counts: []uint64{3, 7, 11},
+			},
+		},
+		{
+			name: "aligned bucket scale 2",
+			bucket: metricdata.ExponentialBucket{
+				Offset: 0,
+				Counts: []uint64{1, 2, 3, 4, 5, 6},
+			},
+			scaleDelta: 2,
+			want: metricdata.ExponentialBucket{
+				Offset: 0,
+				Counts: []uint64{10, 11},
+			},
+		},
+		{
+			name: "unaligned bucket scale 1",
+			bucket: metricdata.ExponentialBucket{
+				Offset: 5,
+				Counts: []uint64{1, 2, 3, 4, 5, 6},
+			}, // This is equivalent to [0,0,0,0,0,1,2,3,4,5,6]
+			scaleDelta: 1,
+			want: metricdata.ExponentialBucket{
+				Offset: 2,
+				Counts: []uint64{1, 5, 9, 6},
+			}, // This is equivalent to [0,0,1,5,9,6]
+		},
+		{
+			name: "negative startBin",
+			bucket: metricdata.ExponentialBucket{
+				Offset: -1,
+				Counts: []uint64{1, 0, 3},
+			},
+			scaleDelta: 1,
+			want: metricdata.ExponentialBucket{
+				Offset: -1,
+				Counts: []uint64{1, 3},
+			},
+		},
+		{
+			name: "negative startBin 2",
+			bucket: metricdata.ExponentialBucket{
+				Offset: -4,
+				Counts: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			},
+			scaleDelta: 1,
+			want: metricdata.ExponentialBucket{
+				Offset: -2,
+				Counts: []uint64{3, 7, 11, 15, 19},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DownscaleExponentialBucket(tt.bucket, tt.scaleDelta)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDownscaleExponentialBucketEdgeCases(t *testing.T) {
+	t.Run("min_idx_larger_than_current", func(t *testing.T) {
+		// Test case where we find a minIdx that's smaller than the current
+		bucket := metricdata.ExponentialBucket{
+			Offset: 10, // Start at offset 10
+			Counts: []uint64{1, 0, 0, 0, 1},
+		}
+
+		// Scale delta of 3 will cause downscaling: original indices 10->1, 14->1
+		result := DownscaleExponentialBucket(bucket, 3)
+
+		// Both original buckets 10 and 14 should map to the same downscaled bucket at index 1
+		expected := metricdata.ExponentialBucket{
+			Offset: 1,
+			Counts: []uint64{2}, // Both counts combined
+		}
+
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("empty_downscaled_counts", func(t *testing.T) {
+		// Create a scenario that results in empty downscaled counts
+		bucket := metricdata.ExponentialBucket{
+			Offset: math.MaxInt32 - 5, // Very large offset that won't cause overflow in this case
+			Counts: []uint64{1, 1, 1, 1, 1},
+		}
+
+		// This should work normally and downscale the buckets
+		result := DownscaleExponentialBucket(bucket, 1)
+
+		// Should return bucket with downscaled values
+		expected := metricdata.ExponentialBucket{
+			Offset: 1073741821,        // ((MaxInt32-5) + 0) >> 1 = 1073741821
+			Counts: []uint64{2, 2, 1}, // Buckets get combined during downscaling
+		}
+
+		assert.Equal(t, expected, result)
+	})
+}
+
+func TestDownscaleExponentialBucketOverflow(t *testing.T) {
+	bucket := metricdata.ExponentialBucket{
+		Offset: math.MaxInt32 - 1,
+		Counts: []uint64{1, 1},
+	}
+	got := DownscaleExponentialBucket(bucket, 1)
+	want := metricdata.ExponentialBucket{
+		Offset: (math.MaxInt32 - 1) >> 1,
+		Counts: []uint64{2},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestDownscaleExponentialBucketCopies(t *testing.T) {
+	bucket := metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1, 2}}
+	got := DownscaleExponentialBucket(bucket, 0)
+	got.Counts[0] = 10
+	assert.Equal(t, []uint64{1, 2}, bucket.Counts, "argument modified")
+}
+
+func TestAddBuckets(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b metricdata.ExponentialBucket
+		want metricdata.ExponentialBucket
+	}{
+		{
+			name: "Empty",
+			want: metricdata.ExponentialBucket{},
+		},
+		{
+			name: "EmptyA",
+			b:    metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1}},
+			want: metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1}},
+		},
+		{
+			name: "EmptyB",
+			a:    metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1}},
+			want: metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1}},
+		},
+		{
+			name: "Overlapping",
+			a:    metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 2, 3}},
+			b:    metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{1, 1, 1, 1}},
+			want: metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 3, 4, 1, 1}},
+		},
+		{
+			name: "Disjoint",
+			a:    metricdata.ExponentialBucket{Offset: 4, Counts: []uint64{1}},
+			b:    metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{2}},
+			want: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{2, 0, 0, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, addBuckets(tt.a, tt.b))
+		})
+	}
+}
+
+func TestDiffBuckets(t *testing.T) {
+	a := metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 3, 4, 1, 1}}
+
+	got, err := diffBuckets(a, metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{1, 1, 1, 1}})
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 2, 3, 0, 0}}, got)
+	assert.Equal(t, []uint64{1, 3, 4, 1, 1}, a.Counts, "argument modified")
+
+	_, err = diffBuckets(a, metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{4}})
+	assert.ErrorIs(t, err, ErrNegativeCount)
+
+	_, err = diffBuckets(a, metricdata.ExponentialBucket{Offset: -3, Counts: []uint64{1}})
+	assert.ErrorIs(t, err, ErrNegativeCount, "bucket below offset")
+
+	got, err = diffBuckets(a, metricdata.ExponentialBucket{Offset: -3, Counts: []uint64{0, 0, 1}})
+	require.NoError(t, err, "zero counts outside of a")
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{0, 3, 4, 1, 1}}, got)
+}
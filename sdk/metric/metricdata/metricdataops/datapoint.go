@@ -0,0 +1,324 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	// ErrIncompatible is returned when data is combined that does not
+	// describe the same kind of timeseries, e.g. histograms with different
+	// bucket boundaries or sums with different temporality.
+	ErrIncompatible = errors.New("incompatible metric data")
+
+	// ErrNegativeCount is returned when the difference of two data points
+	// would have a negative count. This usually means the cumulative
+	// timeseries was reset between the two data points.
+	ErrNegativeCount = errors.New("negative count")
+)
+
+// AddDataPoints returns the sum of the data points a and b.
+//
+// The returned data point has the attributes of a, the earliest set start
+// time and the latest time of a and b, and the exemplars of both.
+func AddDataPoints[N int64 | float64](a, b metricdata.DataPoint[N]) metricdata.DataPoint[N] {
+	return metricdata.DataPoint[N]{
+		Attributes: a.Attributes,
+		StartTime:  startTime(a.StartTime, b.StartTime),
+		Time:       latest(a.Time, b.Time),
+		Value:      a.Value + b.Value,
+		Exemplars:  concat(a.Exemplars, b.Exemplars),
+	}
+}
+
+// DiffDataPoints returns the change of the cumulative data point a since the
+// earlier cumulative data point b of the same timeseries.
+//
+// The returned data point has the attributes of a, starts at the time of b,
+// and ends at the time of a. Only the exemplars of a recorded after the time
+// of b are kept.
+func DiffDataPoints[N int64 | float64](a, b metricdata.DataPoint[N]) metricdata.DataPoint[N] {
+	return metricdata.DataPoint[N]{
+		Attributes: a.Attributes,
+		StartTime:  b.Time,
+		Time:       a.Time,
+		Value:      a.Value - b.Value,
+		Exemplars:  exemplarsAfter(a.Exemplars, b.Time),
+	}
+}
+
+// AddHistogramDataPoints returns the sum of the histogram data points a and
+// b.
+//
+// The returned data point has the attributes of a, the earliest set start
+// time and the latest time of a and b, and the exemplars of both. An error
+// wrapping [ErrIncompatible] is returned if the bucket boundaries of a and b
+// differ.
+func AddHistogramDataPoints[N int64 | float64](
+	a, b metricdata.HistogramDataPoint[N],
+) (metricdata.HistogramDataPoint[N], error) {
+	if err := compatibleHistograms(a, b); err != nil {
+		return metricdata.HistogramDataPoint[N]{}, err
+	}
+	counts := make([]uint64, len(a.BucketCounts))
+	for i, n := range a.BucketCounts {
+		counts[i] = n + b.BucketCounts[i]
+	}
+	return metricdata.HistogramDataPoint[N]{
+		Attributes:   a.Attributes,
+		StartTime:    startTime(a.StartTime, b.StartTime),
+		Time:         latest(a.Time, b.Time),
+		Count:        a.Count + b.Count,
+		Bounds:       slices.Clone(a.Bounds),
+		BucketCounts: counts,
+		Min:          minExtrema(a.Min, b.Min),
+		Max:          maxExtrema(a.Max, b.Max),
+		Sum:          a.Sum + b.Sum,
+		Exemplars:    concat(a.Exemplars, b.Exemplars),
+	}, nil
+}
+
+// DiffHistogramDataPoints returns the change of the cumulative histogram
+// data point a since the earlier cumulative histogram data point b of the
+// same timeseries.
+//
+// The returned data point has the attributes of a, starts at the time of b,
+// and ends at the time of a. Only the exemplars of a recorded after the time
+// of b are kept. The minimum and maximum of the change cannot be determined
+// and are unset.
+//
+// An error wrapping [ErrIncompatible] is returned if the bucket boundaries of
+// a and b differ. An error wrapping [ErrNegativeCount] is returned if any
+// count of b is greater than the one of a.
+func DiffHistogramDataPoints[N int64 | float64](
+	a, b metricdata.HistogramDataPoint[N],
+) (metricdata.HistogramDataPoint[N], error) {
+	if err := compatibleHistograms(a, b); err != nil {
+		return metricdata.HistogramDataPoint[N]{}, err
+	}
+	if a.Count < b.Count {
+		return metricdata.HistogramDataPoint[N]{}, fmt.Errorf("%w: count", ErrNegativeCount)
+	}
+	counts := make([]uint64, len(a.BucketCounts))
+	for i, n := range a.BucketCounts {
+		if n < b.BucketCounts[i] {
+			return metricdata.HistogramDataPoint[N]{}, fmt.Errorf("%w: bucket %d", ErrNegativeCount, i)
+		}
+		counts[i] = n - b.BucketCounts[i]
+	}
+	return metricdata.HistogramDataPoint[N]{
+		Attributes:   a.Attributes,
+		StartTime:    b.Time,
+		Time:         a.Time,
+		Count:        a.Count - b.Count,
+		Bounds:       slices.Clone(a.Bounds),
+		BucketCounts: counts,
+		Sum:          a.Sum - b.Sum,
+		Exemplars:    exemplarsAfter(a.Exemplars, b.Time),
+	}, nil
+}
+
+func compatibleHistograms[N int64 | float64](a, b metricdata.HistogramDataPoint[N]) error {
+	if !slices.Equal(a.Bounds, b.Bounds) {
+		return fmt.Errorf("%w: bounds %v and %v differ", ErrIncompatible, a.Bounds, b.Bounds)
+	}
+	if len(a.BucketCounts) != len(b.BucketCounts) {
+		return fmt.Errorf(
+			"%w: %d and %d bucket counts differ",
+			ErrIncompatible,
+			len(a.BucketCounts),
+			len(b.BucketCounts),
+		)
+	}
+	return nil
+}
+
+// AddExponentialHistogramDataPoints returns the sum of the exponential
+// histogram data points a and b.
+//
+// The returned data point has the attributes of a, the earliest set start
+// time and the latest time of a and b, and the exemplars of both. It has the
+// smaller scale of a and b, the buckets of the other are downscaled to it. An
+// error wrapping [ErrIncompatible] is returned if the zero thresholds of a and
+// b differ.
+func AddExponentialHistogramDataPoints[N int64 | float64](
+	a, b metricdata.ExponentialHistogramDataPoint[N],
+) (metricdata.ExponentialHistogramDataPoint[N], error) {
+	if a.ZeroThreshold != b.ZeroThreshold {
+		return metricdata.ExponentialHistogramDataPoint[N]{}, fmt.Errorf(
+			"%w: zero thresholds %v and %v differ",
+			ErrIncompatible,
+			a.ZeroThreshold,
+			b.ZeroThreshold,
+		)
+	}
+	scale := min(a.Scale, b.Scale)
+	return metricdata.ExponentialHistogramDataPoint[N]{
+		Attributes: a.Attributes,
+		StartTime:  startTime(a.StartTime, b.StartTime),
+		Time:       latest(a.Time, b.Time),
+		Count:      a.Count + b.Count,
+		Min:        minExtrema(a.Min, b.Min),
+		Max:        maxExtrema(a.Max, b.Max),
+		Sum:        a.Sum + b.Sum,
+		Scale:      scale,
+		ZeroCount:  a.ZeroCount + b.ZeroCount,
+		PositiveBucket: addBuckets(
+			DownscaleExponentialBucket(a.PositiveBucket, a.Scale-scale),
+			DownscaleExponentialBucket(b.PositiveBucket, b.Scale-scale),
+		),
+		NegativeBucket: addBuckets(
+			DownscaleExponentialBucket(a.NegativeBucket, a.Scale-scale),
+			DownscaleExponentialBucket(b.NegativeBucket, b.Scale-scale),
+		),
+		ZeroThreshold: a.ZeroThreshold,
+		Exemplars:     concat(a.Exemplars, b.Exemplars),
+	}, nil
+}
+
+// DiffExponentialHistogramDataPoints returns the change of the cumulative
+// exponential histogram data point a since the earlier cumulative exponential
+// histogram data point b of the same timeseries.
+//
+// The returned data point has the attributes of a, starts at the time of b,
+// and ends at the time of a. Only the exemplars of a recorded after the time
+// of b are kept. The minimum and maximum of the change cannot be determined
+// and are unset. It has the smaller scale of a and b, the buckets of the
+// other are downscaled to it.
+//
+// An error wrapping [ErrIncompatible] is returned if the zero thresholds of a
+// and b differ. An error wrapping [ErrNegativeCount] is returned if any count
+// of b is greater than the one of a.
+func DiffExponentialHistogramDataPoints[N int64 | float64](
+	a, b metricdata.ExponentialHistogramDataPoint[N],
+) (metricdata.ExponentialHistogramDataPoint[N], error) {
+	var zero metricdata.ExponentialHistogramDataPoint[N]
+	if a.ZeroThreshold != b.ZeroThreshold {
+		return zero, fmt.Errorf(
+			"%w: zero thresholds %v and %v differ",
+			ErrIncompatible,
+			a.ZeroThreshold,
+			b.ZeroThreshold,
+		)
+	}
+	if a.Count < b.Count {
+		return zero, fmt.Errorf("%w: count", ErrNegativeCount)
+	}
+	if a.ZeroCount < b.ZeroCount {
+		return zero, fmt.Errorf("%w: zero count", ErrNegativeCount)
+	}
+
+	scale := min(a.Scale, b.Scale)
+	pos, err := diffBuckets(
+		DownscaleExponentialBucket(a.PositiveBucket, a.Scale-scale),
+		DownscaleExponentialBucket(b.PositiveBucket, b.Scale-scale),
+	)
+	if err != nil {
+		return zero, fmt.Errorf("positive %w", err)
+	}
+	neg, err := diffBuckets(
+		DownscaleExponentialBucket(a.NegativeBucket, a.Scale-scale),
+		DownscaleExponentialBucket(b.NegativeBucket, b.Scale-scale),
+	)
+	if err != nil {
+		return zero, fmt.Errorf("negative %w", err)
+	}
+
+	return metricdata.ExponentialHistogramDataPoint[N]{
+		Attributes:     a.Attributes,
+		StartTime:      b.Time,
+		Time:           a.Time,
+		Count:          a.Count - b.Count,
+		Sum:            a.Sum - b.Sum,
+		Scale:          scale,
+		ZeroCount:      a.ZeroCount - b.ZeroCount,
+		PositiveBucket: pos,
+		NegativeBucket: neg,
+		ZeroThreshold:  a.ZeroThreshold,
+		Exemplars:      exemplarsAfter(a.Exemplars, b.Time),
+	}, nil
+}
+
+// RescaleExponentialHistogramDataPoint returns dp with its buckets
+// downscaled to scale.
+//
+// An error wrapping [ErrIncompatible] is returned if scale is greater than
+// the scale of dp, the resolution of a data point cannot be increased.
+func RescaleExponentialHistogramDataPoint[N int64 | float64](
+	dp metricdata.ExponentialHistogramDataPoint[N],
+	scale int32,
+) (metricdata.ExponentialHistogramDataPoint[N], error) {
+	if scale > dp.Scale {
+		return metricdata.ExponentialHistogramDataPoint[N]{}, fmt.Errorf(
+			"%w: cannot increase scale %d to %d",
+			ErrIncompatible,
+			dp.Scale,
+			scale,
+		)
+	}
+	dp.PositiveBucket = DownscaleExponentialBucket(dp.PositiveBucket, dp.Scale-scale)
+	dp.NegativeBucket = DownscaleExponentialBucket(dp.NegativeBucket, dp.Scale-scale)
+	dp.Scale = scale
+	return dp, nil
+}
+
+// startTime returns the earliest of a and b that is set.
+func startTime(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// latest returns the latest of a and b.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// concat returns a new slice holding the elements of a followed by b. If both
+// are empty, nil is returned.
+func concat[T any](a, b []T) []T {
+	if len(a)+len(b) == 0 {
+		return nil
+	}
+	return slices.Concat(a, b)
+}
+
+// exemplarsAfter returns the exemplars in e recorded after t.
+func exemplarsAfter[N int64 | float64](e []metricdata.Exemplar[N], t time.Time) []metricdata.Exemplar[N] {
+	var out []metricdata.Exemplar[N]
+	for _, ex := range e {
+		if ex.Time.After(t) {
+			out = append(out, ex)
+		}
+	}
+	return out
+}
+
+func minExtrema[N int64 | float64](a, b metricdata.Extrema[N]) metricdata.Extrema[N] {
+	av, aOK := a.Value()
+	bv, bOK := b.Value()
+	if !aOK || (bOK && bv < av) {
+		return b
+	}
+	return a
+}
+
+func maxExtrema[N int64 | float64](a, b metricdata.Extrema[N]) metricdata.Extrema[N] {
+	av, aOK := a.Value()
+	bv, bOK := b.Value()
+	if !aOK || (bOK && bv > av) {
+		return b
+	}
+	return a
+}
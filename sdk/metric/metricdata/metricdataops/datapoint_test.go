@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	alice = attribute.NewSet(attribute.String("user", "alice"))
+	bob   = attribute.NewSet(attribute.String("user", "bob"))
+
+	t0 = time.Unix(1000, 0)
+	t1 = t0.Add(time.Second)
+	t2 = t1.Add(time.Second)
+	t3 = t2.Add(time.Second)
+)
+
+func exemplar[N int64 | float64](t time.Time, v N) metricdata.Exemplar[N] {
+	return metricdata.Exemplar[N]{Time: t, Value: v}
+}
+
+func TestAddDataPoints(t *testing.T) {
+	a := metricdata.DataPoint[int64]{
+		Attributes: alice,
+		StartTime:  t1,
+		Time:       t2,
+		Value:      2,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplar[int64](t2, 2)},
+	}
+	b := metricdata.DataPoint[int64]{
+		Attributes: alice,
+		StartTime:  t0,
+		Time:       t1,
+		Value:      3,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplar[int64](t1, 3)},
+	}
+
+	want := metricdata.DataPoint[int64]{
+		Attributes: alice,
+		StartTime:  t0,
+		Time:       t2,
+		Value:      5,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplar[int64](t2, 2), exemplar[int64](t1, 3)},
+	}
+	assert.Equal(t, want, AddDataPoints(a, b))
+
+	// A start time that is not set is ignored.
+	b.StartTime = time.Time{}
+	assert.Equal(t, t1, AddDataPoints(a, b).StartTime)
+	assert.Equal(t, t1, AddDataPoints(b, a).StartTime)
+}
+
+func TestDiffDataPoints(t *testing.T) {
+	a := metricdata.DataPoint[float64]{
+		Attributes: alice,
+		StartTime:  t0,
+		Time:       t2,
+		Value:      5,
+		Exemplars: []metricdata.Exemplar[float64]{
+			exemplar[float64](t1, 1),
+			exemplar[float64](t2, 2),
+		},
+	}
+	b := metricdata.DataPoint[float64]{Attributes: alice, StartTime: t0, Time: t1, Value: 3}
+
+	want := metricdata.DataPoint[float64]{
+		Attributes: alice,
+		StartTime:  t1,
+		Time:       t2,
+		Value:      2,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplar[float64](t2, 2)},
+	}
+	assert.Equal(t, want, DiffDataPoints(a, b))
+}
+
+func histogramDataPoint(start, end time.Time, counts ...uint64) metricdata.HistogramDataPoint[int64] {
+	var count uint64
+	for _, n := range counts {
+		count += n
+	}
+	return metricdata.HistogramDataPoint[int64]{
+		Attributes:   alice,
+		StartTime:    start,
+		Time:         end,
+		Count:        count,
+		Bounds:       []float64{0, 10},
+		BucketCounts: counts,
+		Sum:          int64(count) * 5, //nolint:gosec // Small test values.
+	}
+}
+
+func TestAddHistogramDataPoints(t *testing.T) {
+	a := histogramDataPoint(t0, t1, 1, 2, 0)
+	a.Min, a.Max = metricdata.NewExtrema[int64](-1), metricdata.NewExtrema[int64](8)
+	b := histogramDataPoint(t1, t2, 0, 1, 3)
+	b.Max = metricdata.NewExtrema[int64](20)
+
+	got, err := AddHistogramDataPoints(a, b)
+	require.NoError(t, err)
+
+	want := histogramDataPoint(t0, t2, 1, 3, 3)
+	want.Min, want.Max = metricdata.NewExtrema[int64](-1), metricdata.NewExtrema[int64](20)
+	assert.Equal(t, want, got)
+
+	got.BucketCounts[0] = 10
+	got.Bounds[0] = 10
+	assert.Equal(t, histogramDataPoint(t0, t1, 1, 2, 0).BucketCounts, a.BucketCounts, "argument modified")
+	assert.Equal(t, []float64{0, 10}, a.Bounds, "argument modified")
+}
+
+func TestAddHistogramDataPointsIncompatible(t *testing.T) {
+	a := histogramDataPoint(t0, t1, 1, 2, 0)
+
+	b := histogramDataPoint(t0, t1, 1, 2, 0)
+	b.Bounds = []float64{0, 5}
+	_, err := AddHistogramDataPoints(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible, "bounds")
+
+	b = histogramDataPoint(t0, t1, 1, 2)
+	_, err = AddHistogramDataPoints(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible, "bucket counts")
+}
+
+func TestDiffHistogramDataPoints(t *testing.T) {
+	a := histogramDataPoint(t0, t2, 1, 3, 3)
+	a.Min, a.Max = metricdata.NewExtrema[int64](-1), metricdata.NewExtrema[int64](20)
+	a.Exemplars = []metricdata.Exemplar[int64]{exemplar[int64](t1, 1), exemplar[int64](t2, 2)}
+	b := histogramDataPoint(t0, t1, 1, 2, 0)
+
+	got, err := DiffHistogramDataPoints(a, b)
+	require.NoError(t, err)
+
+	want := histogramDataPoint(t1, t2, 0, 1, 3)
+	want.Exemplars = []metricdata.Exemplar[int64]{exemplar[int64](t2, 2)}
+	assert.Equal(t, want, got)
+
+	_, err = DiffHistogramDataPoints(b, a)
+	assert.ErrorIs(t, err, ErrNegativeCount)
+
+	// The total count can grow while a bucket count decreases if the
+	// timeseries was reset.
+	_, err = DiffHistogramDataPoints(histogramDataPoint(t0, t2, 0, 5, 5), b)
+	assert.ErrorIs(t, err, ErrNegativeCount)
+
+	b.Bounds = []float64{1}
+	_, err = DiffHistogramDataPoints(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func expoDataPoint(
+	start, end time.Time,
+	scale int32,
+	zero uint64,
+	pos, neg metricdata.ExponentialBucket,
+) metricdata.ExponentialHistogramDataPoint[float64] {
+	count := zero
+	for _, n := range pos.Counts {
+		count += n
+	}
+	for _, n := range neg.Counts {
+		count += n
+	}
+	return metricdata.ExponentialHistogramDataPoint[float64]{
+		Attributes:     alice,
+		StartTime:      start,
+		Time:           end,
+		Count:          count,
+		Sum:            float64(count),
+		Scale:          scale,
+		ZeroCount:      zero,
+		PositiveBucket: pos,
+		NegativeBucket: neg,
+		ZeroThreshold:  0.1,
+	}
+}
+
+func bucket(offset int32, counts ...uint64) metricdata.ExponentialBucket {
+	return metricdata.ExponentialBucket{Offset: offset, Counts: counts}
+}
+
+func TestAddExponentialHistogramDataPoints(t *testing.T) {
+	a := expoDataPoint(t0, t1, 2, 1, bucket(0, 1, 2, 3, 4), bucket(-2, 1, 1))
+	a.Min, a.Max = metricdata.NewExtrema(-4.0), metricdata.NewExtrema(4.0)
+	b := expoDataPoint(t1, t2, 1, 2, bucket(1, 5), bucket(0))
+	b.Min, b.Max = metricdata.NewExtrema(-1.0), metricdata.NewExtrema(8.0)
+
+	got, err := AddExponentialHistogramDataPoints(a, b)
+	require.NoError(t, err)
+
+	want := expoDataPoint(t0, t2, 1, 3, bucket(0, 3, 12), bucket(-1, 2))
+	want.Min, want.Max = metricdata.NewExtrema(-4.0), metricdata.NewExtrema(8.0)
+	assert.Equal(t, want, got)
+
+	// Addition needs to be commutative apart from the attributes used.
+	rev, err := AddExponentialHistogramDataPoints(b, a)
+	require.NoError(t, err)
+	assert.Equal(t, got, rev)
+
+	b.ZeroThreshold = 1
+	_, err = AddExponentialHistogramDataPoints(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func TestDiffExponentialHistogramDataPoints(t *testing.T) {
+	a := expoDataPoint(t0, t2, 1, 3, bucket(0, 3, 12), bucket(-1, 2))
+	a.Min, a.Max = metricdata.NewExtrema(-4.0), metricdata.NewExtrema(8.0)
+	b := expoDataPoint(t0, t1, 2, 1, bucket(0, 1, 2, 3, 4), bucket(-2, 1, 1))
+
+	got, err := DiffExponentialHistogramDataPoints(a, b)
+	require.NoError(t, err)
+	want := expoDataPoint(t1, t2, 1, 2, bucket(0, 0, 5), bucket(-1, 0))
+	assert.Equal(t, want, got)
+
+	_, err = DiffExponentialHistogramDataPoints(b, a)
+	assert.ErrorIs(t, err, ErrNegativeCount, "count")
+
+	c := expoDataPoint(t0, t2, 1, 0, bucket(0, 10, 10), bucket(0))
+	_, err = DiffExponentialHistogramDataPoints(c, b)
+	assert.ErrorIs(t, err, ErrNegativeCount, "zero count")
+
+	c = expoDataPoint(t0, t2, 2, 1, bucket(0, 1, 2, 3, 4), bucket(-2, 0, 10))
+	_, err = DiffExponentialHistogramDataPoints(c, b)
+	assert.ErrorIs(t, err, ErrNegativeCount, "negative bucket")
+
+	c = expoDataPoint(t0, t2, 2, 1, bucket(0, 0, 20), bucket(-2, 1, 1))
+	_, err = DiffExponentialHistogramDataPoints(c, b)
+	assert.ErrorIs(t, err, ErrNegativeCount, "positive bucket")
+
+	b.ZeroThreshold = 1
+	_, err = DiffExponentialHistogramDataPoints(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func TestRescaleExponentialHistogramDataPoint(t *testing.T) {
+	dp := expoDataPoint(t0, t1, 2, 1, bucket(0, 1, 2, 3, 4), bucket(-2, 1, 1))
+
+	got, err := RescaleExponentialHistogramDataPoint(dp, 0)
+	require.NoError(t, err)
+	assert.Equal(t, expoDataPoint(t0, t1, 0, 1, bucket(0, 10), bucket(-1, 2)), got)
+	assert.Equal(t, []uint64{1, 2, 3, 4}, dp.PositiveBucket.Counts, "argument modified")
+
+	_, err = RescaleExponentialHistogramDataPoint(dp, 3)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
@@ -0,0 +1,15 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metricdataops provides arithmetic and merging operations for the
+// data of the metricdata package.
+//
+// The Add functions combine two data points into one, as if all measurements
+// of both had been aggregated together. The Diff functions compute the change
+// between two cumulative data points of the same timeseries. The Merge
+// functions combine whole aggregations, metrics, and collections, matching
+// data points by their attributes.
+//
+// None of the functions modify their arguments. Returned values may share
+// memory, like exemplar slices, with the arguments.
+package metricdataops
@@ -0,0 +1,307 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// MergeSum returns the merge of the sums a and b. Data points with the same
+// attributes are added with [AddDataPoints], all others are kept.
+//
+// An error wrapping [ErrIncompatible] is returned if the temporality or
+// monotonicity of a and b differ.
+func MergeSum[N int64 | float64](a, b metricdata.Sum[N]) (metricdata.Sum[N], error) {
+	if a.Temporality != b.Temporality || a.IsMonotonic != b.IsMonotonic {
+		return metricdata.Sum[N]{}, fmt.Errorf(
+			"%w: sum temporality %s and %s or monotonicity %t and %t differ",
+			ErrIncompatible,
+			a.Temporality,
+			b.Temporality,
+			a.IsMonotonic,
+			b.IsMonotonic,
+		)
+	}
+	dps, err := mergePoints(a.DataPoints, b.DataPoints, dataPointAttrs, infallible(AddDataPoints[N]))
+	return metricdata.Sum[N]{DataPoints: dps, Temporality: a.Temporality, IsMonotonic: a.IsMonotonic}, err
+}
+
+// MergeGauge returns the merge of the gauges a and b. Of data points with
+// the same attributes the latest one is kept, all others are kept as well.
+func MergeGauge[N int64 | float64](a, b metricdata.Gauge[N]) metricdata.Gauge[N] {
+	dps, _ := mergePoints(a.DataPoints, b.DataPoints, dataPointAttrs, infallible(latestDataPoint[N]))
+	return metricdata.Gauge[N]{DataPoints: dps}
+}
+
+// MergeHistogram returns the merge of the histograms a and b. Data points
+// with the same attributes are added with [AddHistogramDataPoints], all others
+// are kept.
+//
+// An error wrapping [ErrIncompatible] is returned if the temporality of a and
+// b differ. Errors adding data points are returned joined, the data point of
+// a is kept for them.
+func MergeHistogram[N int64 | float64](a, b metricdata.Histogram[N]) (metricdata.Histogram[N], error) {
+	if a.Temporality != b.Temporality {
+		return metricdata.Histogram[N]{}, fmt.Errorf(
+			"%w: histogram temporality %s and %s differ",
+			ErrIncompatible,
+			a.Temporality,
+			b.Temporality,
+		)
+	}
+	dps, err := mergePoints(a.DataPoints, b.DataPoints, histogramAttrs, AddHistogramDataPoints[N])
+	return metricdata.Histogram[N]{DataPoints: dps, Temporality: a.Temporality}, err
+}
+
+// MergeExponentialHistogram returns the merge of the exponential histograms
+// a and b. Data points with the same attributes are added with
+// [AddExponentialHistogramDataPoints], all others are kept.
+//
+// An error wrapping [ErrIncompatible] is returned if the temporality of a and
+// b differ. Errors adding data points are returned joined, the data point of
+// a is kept for them.
+func MergeExponentialHistogram[N int64 | float64](
+	a, b metricdata.ExponentialHistogram[N],
+) (metricdata.ExponentialHistogram[N], error) {
+	if a.Temporality != b.Temporality {
+		return metricdata.ExponentialHistogram[N]{}, fmt.Errorf(
+			"%w: exponential histogram temporality %s and %s differ",
+			ErrIncompatible,
+			a.Temporality,
+			b.Temporality,
+		)
+	}
+	dps, err := mergePoints(a.DataPoints, b.DataPoints, expoHistogramAttrs, AddExponentialHistogramDataPoints[N])
+	return metricdata.ExponentialHistogram[N]{DataPoints: dps, Temporality: a.Temporality}, err
+}
+
+// MergeSummary returns the merge of the summaries a and b. Quantiles cannot
+// be merged, of data points with the same attributes the latest one is kept.
+// All others are kept as well.
+func MergeSummary(a, b metricdata.Summary) metricdata.Summary {
+	dps, _ := mergePoints(a.DataPoints, b.DataPoints, summaryAttrs, infallible(latestSummary))
+	return metricdata.Summary{DataPoints: dps}
+}
+
+// MergeMetrics returns the merge of the metrics a and b. The data of a and b
+// is merged with the Merge function of its type. The description and unit of
+// a are used, unless they are empty.
+//
+// An error wrapping [ErrIncompatible] is returned if the names or data types
+// of a and b differ.
+func MergeMetrics(a, b metricdata.Metrics) (metricdata.Metrics, error) {
+	if a.Name != b.Name {
+		return metricdata.Metrics{}, fmt.Errorf("%w: names %q and %q differ", ErrIncompatible, a.Name, b.Name)
+	}
+	out := metricdata.Metrics{Name: a.Name, Description: a.Description, Unit: a.Unit}
+	if out.Description == "" {
+		out.Description = b.Description
+	}
+	if out.Unit == "" {
+		out.Unit = b.Unit
+	}
+
+	var err error
+	switch x := a.Data.(type) {
+	case metricdata.Sum[int64]:
+		out.Data, err = mergeData(x, b.Data, MergeSum[int64])
+	case metricdata.Sum[float64]:
+		out.Data, err = mergeData(x, b.Data, MergeSum[float64])
+	case metricdata.Gauge[int64]:
+		out.Data, err = mergeData(x, b.Data, infallible(MergeGauge[int64]))
+	case metricdata.Gauge[float64]:
+		out.Data, err = mergeData(x, b.Data, infallible(MergeGauge[float64]))
+	case metricdata.Histogram[int64]:
+		out.Data, err = mergeData(x, b.Data, MergeHistogram[int64])
+	case metricdata.Histogram[float64]:
+		out.Data, err = mergeData(x, b.Data, MergeHistogram[float64])
+	case metricdata.ExponentialHistogram[int64]:
+		out.Data, err = mergeData(x, b.Data, MergeExponentialHistogram[int64])
+	case metricdata.ExponentialHistogram[float64]:
+		out.Data, err = mergeData(x, b.Data, MergeExponentialHistogram[float64])
+	case metricdata.Summary:
+		out.Data, err = mergeData(x, b.Data, infallible(MergeSummary))
+	default:
+		err = fmt.Errorf("%w: unknown data type %T", ErrIncompatible, a.Data)
+	}
+	if err != nil {
+		return metricdata.Metrics{}, fmt.Errorf("metric %q: %w", a.Name, err)
+	}
+	return out, nil
+}
+
+// mergeData merges a with b using merge if b is of the same type as a.
+func mergeData[T metricdata.Aggregation](
+	a T,
+	b metricdata.Aggregation,
+	merge func(T, T) (T, error),
+) (metricdata.Aggregation, error) {
+	other, ok := b.(T)
+	if !ok {
+		return nil, fmt.Errorf("%w: data types %T and %T differ", ErrIncompatible, a, b)
+	}
+	return merge(a, other)
+}
+
+func infallible[T any](f func(T, T) T) func(T, T) (T, error) {
+	return func(a, b T) (T, error) { return f(a, b), nil }
+}
+
+// MergeScopeMetrics returns the merge of all sms, e.g. the output of several
+// producers.
+//
+// Scope metrics of the same instrumentation scope are combined into one, and
+// their metrics with the same name are merged with [MergeMetrics]. The order
+// in which scopes and metrics first appear is kept.
+//
+// Errors merging metrics are returned joined, the first of the metrics is
+// kept for them.
+func MergeScopeMetrics(sms ...[]metricdata.ScopeMetrics) ([]metricdata.ScopeMetrics, error) {
+	var (
+		out    []metricdata.ScopeMetrics
+		scopes = make(map[scopeKey]int)
+		// metrics maps a metric name to its index for each scope in out.
+		metrics []map[string]int
+		err     error
+	)
+	for _, s := range sms {
+		for _, sm := range s {
+			key := newScopeKey(sm.Scope)
+			i, ok := scopes[key]
+			if !ok {
+				i = len(out)
+				scopes[key] = i
+				out = append(out, metricdata.ScopeMetrics{Scope: sm.Scope})
+				metrics = append(metrics, make(map[string]int))
+			}
+
+			for _, m := range sm.Metrics {
+				j, ok := metrics[i][m.Name]
+				if !ok {
+					metrics[i][m.Name] = len(out[i].Metrics)
+					out[i].Metrics = append(out[i].Metrics, m)
+					continue
+				}
+				merged, e := MergeMetrics(out[i].Metrics[j], m)
+				if e != nil {
+					err = errors.Join(err, fmt.Errorf("scope %q: %w", sm.Scope.Name, e))
+					continue
+				}
+				out[i].Metrics[j] = merged
+			}
+		}
+	}
+	return out, err
+}
+
+// MergeResourceMetrics returns the merge of all rms, e.g. the collections of
+// several readers or producers.
+//
+// The resources of rms are merged with [resource.Merge] and their scope
+// metrics with [MergeScopeMetrics]. Errors are returned joined. If resources
+// cannot be merged, the resource merged so far is kept.
+func MergeResourceMetrics(rms ...metricdata.ResourceMetrics) (metricdata.ResourceMetrics, error) {
+	var (
+		res *resource.Resource
+		sms = make([][]metricdata.ScopeMetrics, 0, len(rms))
+		err error
+	)
+	for _, rm := range rms {
+		merged, e := resource.Merge(res, rm.Resource)
+		if e != nil {
+			err = errors.Join(err, e)
+		} else {
+			res = merged
+		}
+		sms = append(sms, rm.ScopeMetrics)
+	}
+
+	out, e := MergeScopeMetrics(sms...)
+	return metricdata.ResourceMetrics{Resource: res, ScopeMetrics: out}, errors.Join(err, e)
+}
+
+// scopeKey is a comparable identifier of an instrumentation scope.
+type scopeKey struct {
+	name, version, schemaURL string
+	attrs                    attribute.Distinct
+}
+
+func newScopeKey(s instrumentation.Scope) scopeKey {
+	return scopeKey{
+		name:      s.Name,
+		version:   s.Version,
+		schemaURL: s.SchemaURL,
+		attrs:     s.Attributes.Equivalent(),
+	}
+}
+
+// mergePoints returns the data points of a and b, combining the ones with the
+// same attributes using combine. The order in which data points first appear
+// is kept.
+func mergePoints[P any](
+	a, b []P,
+	attrs func(*P) attribute.Set,
+	combine func(P, P) (P, error),
+) ([]P, error) {
+	out := make([]P, 0, len(a)+len(b))
+	idx := make(map[attribute.Distinct]int, len(a)+len(b))
+	var err error
+	for _, dps := range [2][]P{a, b} {
+		for _, dp := range dps {
+			set := attrs(&dp)
+			key := set.Equivalent()
+			i, ok := idx[key]
+			if !ok {
+				idx[key] = len(out)
+				out = append(out, dp)
+				continue
+			}
+			c, e := combine(out[i], dp)
+			if e != nil {
+				err = errors.Join(err, e)
+				continue
+			}
+			out[i] = c
+		}
+	}
+	return out, err
+}
+
+func dataPointAttrs[N int64 | float64](dp *metricdata.DataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func histogramAttrs[N int64 | float64](dp *metricdata.HistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func expoHistogramAttrs[N int64 | float64](dp *metricdata.ExponentialHistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func summaryAttrs(dp *metricdata.SummaryDataPoint) attribute.Set {
+	return dp.Attributes
+}
+
+// latestDataPoint returns the one of a and b with the latest time, b if equal.
+func latestDataPoint[N int64 | float64](a, b metricdata.DataPoint[N]) metricdata.DataPoint[N] {
+	if a.Time.After(b.Time) {
+		return a
+	}
+	return b
+}
+
+// latestSummary returns the one of a and b with the latest time, b if equal.
+func latestSummary(a, b metricdata.SummaryDataPoint) metricdata.SummaryDataPoint {
+	if a.Time.After(b.Time) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func sumPoint(attrs attribute.Set, v int64) metricdata.DataPoint[int64] {
+	return metricdata.DataPoint[int64]{Attributes: attrs, StartTime: t0, Time: t1, Value: v}
+}
+
+func sum(dps ...metricdata.DataPoint[int64]) metricdata.Sum[int64] {
+	return metricdata.Sum[int64]{
+		DataPoints:  dps,
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+	}
+}
+
+func TestMergeSum(t *testing.T) {
+	a := sum(sumPoint(alice, 1))
+	b := sum(sumPoint(bob, 2), sumPoint(alice, 3))
+
+	got, err := MergeSum(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, sum(sumPoint(alice, 4), sumPoint(bob, 2)), got)
+
+	b.Temporality = metricdata.CumulativeTemporality
+	_, err = MergeSum(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible, "temporality")
+
+	b = sum()
+	b.IsMonotonic = false
+	_, err = MergeSum(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible, "monotonicity")
+}
+
+func TestMergeGauge(t *testing.T) {
+	older := metricdata.DataPoint[float64]{Attributes: alice, Time: t1, Value: 1}
+	newer := metricdata.DataPoint[float64]{Attributes: alice, Time: t2, Value: 2}
+	other := metricdata.DataPoint[float64]{Attributes: bob, Time: t0, Value: 3}
+
+	a := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{newer}}
+	b := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{other, older}}
+
+	want := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{newer, other}}
+	assert.Equal(t, want, MergeGauge(a, b))
+
+	want = metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{other, newer}}
+	assert.Equal(t, want, MergeGauge(b, a))
+}
+
+func TestMergeHistogram(t *testing.T) {
+	hist := func(dps ...metricdata.HistogramDataPoint[int64]) metricdata.Histogram[int64] {
+		return metricdata.Histogram[int64]{DataPoints: dps, Temporality: metricdata.CumulativeTemporality}
+	}
+	a := hist(histogramDataPoint(t0, t1, 1, 2, 0))
+	b := hist(histogramDataPoint(t1, t2, 0, 1, 3))
+
+	got, err := MergeHistogram(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, hist(histogramDataPoint(t0, t2, 1, 3, 3)), got)
+
+	// Data points that cannot be added are reported, the ones of a are kept.
+	incompatible := histogramDataPoint(t1, t2, 0, 1)
+	incompatible.Bounds = []float64{1}
+	got, err = MergeHistogram(a, hist(incompatible))
+	assert.ErrorIs(t, err, ErrIncompatible)
+	assert.Equal(t, a, got)
+
+	b.Temporality = metricdata.DeltaTemporality
+	_, err = MergeHistogram(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible, "temporality")
+}
+
+func TestMergeExponentialHistogram(t *testing.T) {
+	hist := func(dps ...metricdata.ExponentialHistogramDataPoint[float64]) metricdata.ExponentialHistogram[float64] {
+		return metricdata.ExponentialHistogram[float64]{DataPoints: dps, Temporality: metricdata.DeltaTemporality}
+	}
+	bobPoint := expoDataPoint(t0, t1, 0, 1, bucket(0), bucket(0))
+	bobPoint.Attributes = bob
+	a := hist(expoDataPoint(t0, t1, 2, 1, bucket(0, 1, 2, 3, 4), bucket(-2, 1, 1)))
+	b := hist(bobPoint, expoDataPoint(t1, t2, 1, 2, bucket(1, 5), bucket(0)))
+
+	got, err := MergeExponentialHistogram(a, b)
+	require.NoError(t, err)
+	want := hist(expoDataPoint(t0, t2, 1, 3, bucket(0, 3, 12), bucket(-1, 2)), bobPoint)
+	assert.Equal(t, want, got)
+
+	b.Temporality = metricdata.CumulativeTemporality
+	_, err = MergeExponentialHistogram(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible, "temporality")
+}
+
+func TestMergeSummary(t *testing.T) {
+	older := metricdata.SummaryDataPoint{Attributes: alice, Time: t1, Count: 1}
+	newer := metricdata.SummaryDataPoint{Attributes: alice, Time: t2, Count: 2}
+
+	a := metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{older}}
+	b := metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{newer}}
+
+	want := metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{newer}}
+	assert.Equal(t, want, MergeSummary(a, b))
+	assert.Equal(t, want, MergeSummary(b, a))
+}
+
+func TestMergeMetrics(t *testing.T) {
+	a := metricdata.Metrics{Name: "requests", Unit: "{request}", Data: sum(sumPoint(alice, 1))}
+	b := metricdata.Metrics{Name: "requests", Description: "Requests", Unit: "1", Data: sum(sumPoint(alice, 2))}
+
+	got, err := MergeMetrics(a, b)
+	require.NoError(t, err)
+	want := metricdata.Metrics{
+		Name:        "requests",
+		Description: "Requests",
+		Unit:        "{request}",
+		Data:        sum(sumPoint(alice, 3)),
+	}
+	assert.Equal(t, want, got)
+
+	_, err = MergeMetrics(a, metricdata.Metrics{Name: "other", Data: sum()})
+	assert.ErrorIs(t, err, ErrIncompatible, "name")
+
+	_, err = MergeMetrics(a, metricdata.Metrics{Name: "requests", Data: metricdata.Gauge[int64]{}})
+	assert.ErrorIs(t, err, ErrIncompatible, "data type")
+
+	_, err = MergeMetrics(a, metricdata.Metrics{Name: "requests", Data: metricdata.Sum[float64]{}})
+	assert.ErrorIs(t, err, ErrIncompatible, "number type")
+
+	_, err = MergeMetrics(metricdata.Metrics{Name: "requests"}, metricdata.Metrics{Name: "requests"})
+	assert.ErrorIs(t, err, ErrIncompatible, "no data")
+}
+
+func TestMergeMetricsDataTypes(t *testing.T) {
+	data := []metricdata.Aggregation{
+		metricdata.Sum[int64]{},
+		metricdata.Sum[float64]{},
+		metricdata.Gauge[int64]{},
+		metricdata.Gauge[float64]{},
+		metricdata.Histogram[int64]{},
+		metricdata.Histogram[float64]{},
+		metricdata.ExponentialHistogram[int64]{},
+		metricdata.ExponentialHistogram[float64]{},
+		metricdata.Summary{},
+	}
+	for _, d := range data {
+		m := metricdata.Metrics{Name: "m", Data: d}
+		_, err := MergeMetrics(m, m)
+		assert.NoErrorf(t, err, "%T", d)
+	}
+}
+
+func TestMergeScopeMetrics(t *testing.T) {
+	scopeA := instrumentation.Scope{Name: "a", Version: "v1"}
+	scopeB := instrumentation.Scope{Name: "b"}
+	metric := func(name string, v int64) metricdata.Metrics {
+		return metricdata.Metrics{Name: name, Data: sum(sumPoint(alice, v))}
+	}
+
+	producer1 := []metricdata.ScopeMetrics{
+		{Scope: scopeA, Metrics: []metricdata.Metrics{metric("x", 1), metric("y", 1)}},
+	}
+	producer2 := []metricdata.ScopeMetrics{
+		{Scope: scopeB, Metrics: []metricdata.Metrics{metric("x", 2)}},
+		{Scope: scopeA, Metrics: []metricdata.Metrics{metric("z", 2), metric("x", 2)}},
+	}
+
+	got, err := MergeScopeMetrics(producer1, producer2)
+	require.NoError(t, err)
+	want := []metricdata.ScopeMetrics{
+		{Scope: scopeA, Metrics: []metricdata.Metrics{metric("x", 3), metric("y", 1), metric("z", 2)}},
+		{Scope: scopeB, Metrics: []metricdata.Metrics{metric("x", 2)}},
+	}
+	assert.Equal(t, want, got)
+
+	// Conflicting metrics are reported, the first one is kept.
+	conflict := []metricdata.ScopeMetrics{
+		{Scope: scopeB, Metrics: []metricdata.Metrics{{Name: "x", Data: metricdata.Gauge[int64]{}}}},
+	}
+	got, err = MergeScopeMetrics(producer2, conflict)
+	assert.ErrorIs(t, err, ErrIncompatible)
+	assert.Equal(t, producer2, got)
+}
+
+func TestMergeResourceMetrics(t *testing.T) {
+	scope := instrumentation.Scope{Name: "a"}
+	rm := func(res *resource.Resource, v int64) metricdata.ResourceMetrics {
+		return metricdata.ResourceMetrics{
+			Resource: res,
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Scope:   scope,
+				Metrics: []metricdata.Metrics{{Name: "x", Data: sum(sumPoint(alice, v))}},
+			}},
+		}
+	}
+
+	host := resource.NewSchemaless(attribute.String("host.name", "h"))
+	service := resource.NewSchemaless(attribute.String("service.name", "s"))
+
+	got, err := MergeResourceMetrics(rm(host, 1), rm(service, 2))
+	require.NoError(t, err)
+	want := rm(resource.NewSchemaless(
+		attribute.String("host.name", "h"),
+		attribute.String("service.name", "s"),
+	), 3)
+	assert.Equal(t, want, got)
+
+	// Resources with conflicting schema URLs are reported, the first one is
+	// kept.
+	v1 := resource.NewWithAttributes("https://example.com/1", attribute.String("k", "v"))
+	v2 := resource.NewWithAttributes("https://example.com/2", attribute.String("k", "v"))
+	got, err = MergeResourceMetrics(rm(v1, 1), rm(v2, 2))
+	assert.ErrorIs(t, err, resource.ErrSchemaURLConflict)
+	assert.Equal(t, rm(v1, 3), got)
+
+	got, err = MergeResourceMetrics()
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.ResourceMetrics{}, got)
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrictest
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// AssertSumValue asserts that the data point with the attributes attrs of the
+// sum named name in rm has the value want.
+//
+// If the assertion fails, an error describing the difference is reported to t
+// and false is returned.
+func AssertSumValue[N int64 | float64](
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	want N,
+	attrs ...attribute.KeyValue,
+) bool {
+	t.Helper()
+
+	got, ok := value[metricdata.Sum[N], N](t, rm, name, attrs)
+	return ok && assertEqual(t, name, "sum value", attrs, got, want)
+}
+
+// AssertGaugeValue asserts that the data point with the attributes attrs of
+// the gauge named name in rm has the value want.
+//
+// If the assertion fails, an error describing the difference is reported to t
+// and false is returned.
+func AssertGaugeValue[N int64 | float64](
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	want N,
+	attrs ...attribute.KeyValue,
+) bool {
+	t.Helper()
+
+	got, ok := value[metricdata.Gauge[N], N](t, rm, name, attrs)
+	return ok && assertEqual(t, name, "gauge value", attrs, got, want)
+}
+
+// AssertHistogramCount asserts that the data point with the attributes attrs
+// of the histogram or exponential histogram named name in rm has the count
+// want.
+//
+// If the assertion fails, an error describing the difference is reported to t
+// and false is returned.
+func AssertHistogramCount(
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	want uint64,
+	attrs ...attribute.KeyValue,
+) bool {
+	t.Helper()
+
+	got, ok := histogramCount(t, rm, name, attrs)
+	return ok && assertEqual(t, name, "histogram count", attrs, got, want)
+}
+
+func assertEqual[T comparable](t TestingT, name, field string, attrs []attribute.KeyValue, got, want T) bool {
+	t.Helper()
+
+	if got == want {
+		return true
+	}
+	t.Error(fmt.Sprintf(
+		"metric %q %s: %s differs\n\texpected: %v\n\tactual:   %v",
+		name,
+		formatSet(attribute.NewSet(attrs...)),
+		field,
+		want,
+		got,
+	))
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrictest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssertSumValue(t *testing.T) {
+	rm := testResourceMetrics()
+
+	assert.True(t, AssertSumValue[int64](t, rm, "requests", 3, alice))
+
+	rt := new(recordingT)
+	assert.False(t, AssertSumValue[int64](rt, rm, "requests", 2, bob))
+	assert.Equal(t, []string{
+		"metric \"requests\" {user=bob}: sum value differs\n\texpected: 2\n\tactual:   1",
+	}, rt.errs)
+
+	rt = new(recordingT)
+	assert.False(t, AssertSumValue[int64](rt, rm, "temperature", 2))
+	assert.Len(t, rt.errs, 1, "wrong data type")
+}
+
+func TestAssertGaugeValue(t *testing.T) {
+	rm := testResourceMetrics()
+
+	assert.True(t, AssertGaugeValue(t, rm, "temperature", 21.5))
+
+	rt := new(recordingT)
+	assert.False(t, AssertGaugeValue(rt, rm, "temperature", 20.0))
+	assert.Equal(t, []string{
+		"metric \"temperature\" {}: gauge value differs\n\texpected: 20\n\tactual:   21.5",
+	}, rt.errs)
+}
+
+func TestAssertHistogramCount(t *testing.T) {
+	rm := testResourceMetrics()
+
+	assert.True(t, AssertHistogramCount(t, rm, "latency", 4, alice))
+	assert.True(t, AssertHistogramCount(t, rm, "size", 2))
+
+	rt := new(recordingT)
+	assert.False(t, AssertHistogramCount(rt, rm, "size", 3))
+	assert.Equal(t, []string{
+		"metric \"size\" {}: histogram count differs\n\texpected: 3\n\tactual:   2",
+	}, rt.errs)
+
+	rt = new(recordingT)
+	assert.False(t, AssertHistogramCount(rt, rm, "latency", 4))
+	assert.Len(t, rt.errs, 1, "missing data point")
+}
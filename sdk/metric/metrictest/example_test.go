@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrictest_test
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metrictest"
+)
+
+func ExampleInMemoryReader() {
+	ctx := context.Background()
+
+	// Set up an in-memory reader and meter provider.
+	reader := metrictest.NewInMemoryReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer mp.Shutdown(ctx) //nolint:errcheck // Example code, error handling omitted.
+
+	meter := mp.Meter("example/simple")
+	counter, _ := meter.Int64Counter("payment.requests")
+	counter.Add(ctx, 5, metric.WithAttributes(attribute.String("method", "card")))
+
+	// Collect the metrics and query the collected data. In a test, pass the
+	// *testing.T to report readable errors if the data is not found.
+	rm, _ := reader.GetMetrics(ctx)
+	t := &mockTestingT{}
+	fmt.Println(metrictest.SumValue[int64](t, rm, "payment.requests", attribute.String("method", "card")))
+
+	// Output:
+	// 5
+}
+
+type mockTestingT struct{}
+
+func (*mockTestingT) Helper() {}
+
+func (*mockTestingT) Error(...any) {}
@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrictest
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+// TestingT is an interface that implements [testing.T], but without the
+// private method of [testing.TB], so other testing packages can rely on it as
+// well.
+type TestingT = metricdatatest.TestingT
+
+// FindMetric returns the first metric named name in rm.
+//
+// If rm has no such metric, an error listing the names of all metrics in rm
+// is reported to t and false is returned.
+func FindMetric(t TestingT, rm metricdata.ResourceMetrics, name string) (metricdata.Metrics, bool) {
+	t.Helper()
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m, true
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "metric %q not found", name)
+	if len(rm.ScopeMetrics) == 0 {
+		b.WriteString(", no metrics collected")
+	}
+	for _, sm := range rm.ScopeMetrics {
+		fmt.Fprintf(&b, "\n\tscope %q:", sm.Scope.Name)
+		for _, m := range sm.Metrics {
+			fmt.Fprintf(&b, "\n\t\t%s", m.Name)
+		}
+	}
+	t.Error(b.String())
+	return metricdata.Metrics{}, false
+}
+
+// FindDataPoint returns the data point of the sum or gauge m with exactly the
+// attributes attrs. No attrs refers to the data point without attributes.
+//
+// If m is not a sum or gauge of N values or has no such data point, an error
+// listing the attributes of all data points of m is reported to t and false
+// is returned.
+func FindDataPoint[N int64 | float64](
+	t TestingT,
+	m metricdata.Metrics,
+	attrs ...attribute.KeyValue,
+) (metricdata.DataPoint[N], bool) {
+	t.Helper()
+
+	var dps []metricdata.DataPoint[N]
+	switch data := m.Data.(type) {
+	case metricdata.Sum[N]:
+		dps = data.DataPoints
+	case metricdata.Gauge[N]:
+		dps = data.DataPoints
+	default:
+		var zero N
+		t.Error(fmt.Sprintf("metric %q: %T is not a sum or gauge of %T", m.Name, m.Data, zero))
+		return metricdata.DataPoint[N]{}, false
+	}
+	return findPoint(t, m.Name, dps, func(dp metricdata.DataPoint[N]) attribute.Set { return dp.Attributes }, attrs)
+}
+
+// FindHistogramDataPoint returns the data point of the histogram m with
+// exactly the attributes attrs. No attrs refers to the data point without
+// attributes.
+//
+// If m is not a histogram of N values or has no such data point, an error
+// listing the attributes of all data points of m is reported to t and false
+// is returned.
+func FindHistogramDataPoint[N int64 | float64](
+	t TestingT,
+	m metricdata.Metrics,
+	attrs ...attribute.KeyValue,
+) (metricdata.HistogramDataPoint[N], bool) {
+	t.Helper()
+
+	data, ok := m.Data.(metricdata.Histogram[N])
+	if !ok {
+		var zero N
+		t.Error(fmt.Sprintf("metric %q: %T is not a histogram of %T", m.Name, m.Data, zero))
+		return metricdata.HistogramDataPoint[N]{}, false
+	}
+	return findPoint(
+		t,
+		m.Name,
+		data.DataPoints,
+		func(dp metricdata.HistogramDataPoint[N]) attribute.Set { return dp.Attributes },
+		attrs,
+	)
+}
+
+// FindExponentialHistogramDataPoint returns the data point of the exponential
+// histogram m with exactly the attributes attrs. No attrs refers to the data
+// point without attributes.
+//
+// If m is not an exponential histogram of N values or has no such data point,
+// an error listing the attributes of all data points of m is reported to t
+// and false is returned.
+func FindExponentialHistogramDataPoint[N int64 | float64](
+	t TestingT,
+	m metricdata.Metrics,
+	attrs ...attribute.KeyValue,
+) (metricdata.ExponentialHistogramDataPoint[N], bool) {
+	t.Helper()
+
+	data, ok := m.Data.(metricdata.ExponentialHistogram[N])
+	if !ok {
+		var zero N
+		t.Error(fmt.Sprintf("metric %q: %T is not an exponential histogram of %T", m.Name, m.Data, zero))
+		return metricdata.ExponentialHistogramDataPoint[N]{}, false
+	}
+	return findPoint(
+		t,
+		m.Name,
+		data.DataPoints,
+		func(dp metricdata.ExponentialHistogramDataPoint[N]) attribute.Set { return dp.Attributes },
+		attrs,
+	)
+}
+
+func findPoint[P any](
+	t TestingT,
+	name string,
+	dps []P,
+	attrsOf func(P) attribute.Set,
+	attrs []attribute.KeyValue,
+) (P, bool) {
+	t.Helper()
+
+	want := attribute.NewSet(attrs...)
+	for _, dp := range dps {
+		if set := attrsOf(dp); set.Equals(&want) {
+			return dp, true
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "metric %q: no data point with attributes %s", name, formatSet(want))
+	if len(dps) == 0 {
+		b.WriteString(", metric has no data points")
+	} else {
+		b.WriteString(", data points have attributes:")
+	}
+	for _, dp := range dps {
+		fmt.Fprintf(&b, "\n\t%s", formatSet(attrsOf(dp)))
+	}
+	t.Error(b.String())
+
+	var zero P
+	return zero, false
+}
+
+func formatSet(s attribute.Set) string {
+	return "{" + s.Encoded(attribute.DefaultEncoder()) + "}"
+}
+
+// SumValue returns the value of the data point with the attributes attrs of
+// the sum named name in rm.
+//
+// If no such data point exists, an error describing the collected data is
+// reported to t and zero is returned.
+func SumValue[N int64 | float64](
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	attrs ...attribute.KeyValue,
+) N {
+	t.Helper()
+	v, _ := value[metricdata.Sum[N], N](t, rm, name, attrs)
+	return v
+}
+
+// GaugeValue returns the value of the data point with the attributes attrs of
+// the gauge named name in rm.
+//
+// If no such data point exists, an error describing the collected data is
+// reported to t and zero is returned.
+func GaugeValue[N int64 | float64](
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	attrs ...attribute.KeyValue,
+) N {
+	t.Helper()
+	v, _ := value[metricdata.Gauge[N], N](t, rm, name, attrs)
+	return v
+}
+
+// value returns the value of the data point with attrs of the metric named
+// name in rm, which needs to have data of type A.
+func value[A metricdata.Sum[N] | metricdata.Gauge[N], N int64 | float64](
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	attrs []attribute.KeyValue,
+) (N, bool) {
+	t.Helper()
+
+	m, ok := FindMetric(t, rm, name)
+	if !ok {
+		return 0, false
+	}
+	if _, ok := m.Data.(A); !ok {
+		var want A
+		t.Error(fmt.Sprintf("metric %q: %T is not a %T", name, m.Data, want))
+		return 0, false
+	}
+	dp, ok := FindDataPoint[N](t, m, attrs...)
+	return dp.Value, ok
+}
+
+// HistogramCount returns the count of the data point with the attributes
+// attrs of the histogram or exponential histogram named name in rm.
+//
+// If no such data point exists, an error describing the collected data is
+// reported to t and zero is returned.
+func HistogramCount(t TestingT, rm metricdata.ResourceMetrics, name string, attrs ...attribute.KeyValue) uint64 {
+	t.Helper()
+	n, _ := histogramCount(t, rm, name, attrs)
+	return n
+}
+
+func histogramCount(
+	t TestingT,
+	rm metricdata.ResourceMetrics,
+	name string,
+	attrs []attribute.KeyValue,
+) (uint64, bool) {
+	t.Helper()
+
+	m, ok := FindMetric(t, rm, name)
+	if !ok {
+		return 0, false
+	}
+	switch m.Data.(type) {
+	case metricdata.Histogram[int64]:
+		dp, ok := FindHistogramDataPoint[int64](t, m, attrs...)
+		return dp.Count, ok
+	case metricdata.Histogram[float64]:
+		dp, ok := FindHistogramDataPoint[float64](t, m, attrs...)
+		return dp.Count, ok
+	case metricdata.ExponentialHistogram[int64]:
+		dp, ok := FindExponentialHistogramDataPoint[int64](t, m, attrs...)
+		return dp.Count, ok
+	case metricdata.ExponentialHistogram[float64]:
+		dp, ok := FindExponentialHistogramDataPoint[float64](t, m, attrs...)
+		return dp.Count, ok
+	default:
+		t.Error(fmt.Sprintf("metric %q: %T is not a histogram", name, m.Data))
+		return 0, false
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrictest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// recordingT is a TestingT that records all reported errors.
+type recordingT struct {
+	errs []string
+}
+
+func (*recordingT) Helper() {}
+
+func (t *recordingT) Error(args ...any) {
+	t.errs = append(t.errs, fmt.Sprint(args...))
+}
+
+var (
+	alice = attribute.String("user", "alice")
+	bob   = attribute.String("user", "bob")
+)
+
+func testResourceMetrics() metricdata.ResourceMetrics {
+	return metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: instrumentation.Scope{Name: "a"},
+				Metrics: []metricdata.Metrics{
+					{
+						Name: "requests",
+						Data: metricdata.Sum[int64]{
+							DataPoints: []metricdata.DataPoint[int64]{
+								{Attributes: attribute.NewSet(alice), Value: 3},
+								{Attributes: attribute.NewSet(bob), Value: 1},
+							},
+							Temporality: metricdata.CumulativeTemporality,
+							IsMonotonic: true,
+						},
+					},
+					{
+						Name: "temperature",
+						Data: metricdata.Gauge[float64]{
+							DataPoints: []metricdata.DataPoint[float64]{{Value: 21.5}},
+						},
+					},
+				},
+			},
+			{
+				Scope: instrumentation.Scope{Name: "b"},
+				Metrics: []metricdata.Metrics{
+					{
+						Name: "latency",
+						Data: metricdata.Histogram[float64]{
+							DataPoints: []metricdata.HistogramDataPoint[float64]{
+								{Attributes: attribute.NewSet(alice), Count: 4},
+							},
+						},
+					},
+					{
+						Name: "size",
+						Data: metricdata.ExponentialHistogram[int64]{
+							DataPoints: []metricdata.ExponentialHistogramDataPoint[int64]{{Count: 2}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestFindMetric(t *testing.T) {
+	rm := testResourceMetrics()
+
+	m, ok := FindMetric(t, rm, "latency")
+	assert.True(t, ok)
+	assert.Equal(t, "latency", m.Name)
+
+	rt := new(recordingT)
+	_, ok = FindMetric(rt, rm, "unknown")
+	assert.False(t, ok)
+	want := "metric \"unknown\" not found" +
+		"\n\tscope \"a\":\n\t\trequests\n\t\ttemperature" +
+		"\n\tscope \"b\":\n\t\tlatency\n\t\tsize"
+	assert.Equal(t, []string{want}, rt.errs)
+
+	rt = new(recordingT)
+	_, ok = FindMetric(rt, metricdata.ResourceMetrics{}, "unknown")
+	assert.False(t, ok)
+	assert.Equal(t, []string{`metric "unknown" not found, no metrics collected`}, rt.errs)
+}
+
+func TestFindDataPoint(t *testing.T) {
+	rm := testResourceMetrics()
+	requests, _ := FindMetric(t, rm, "requests")
+
+	dp, ok := FindDataPoint[int64](t, requests, bob)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), dp.Value)
+
+	rt := new(recordingT)
+	_, ok = FindDataPoint[int64](rt, requests)
+	assert.False(t, ok)
+	want := "metric \"requests\": no data point with attributes {}, data points have attributes:" +
+		"\n\t{user=alice}\n\t{user=bob}"
+	assert.Equal(t, []string{want}, rt.errs)
+
+	rt = new(recordingT)
+	_, ok = FindDataPoint[float64](rt, requests, bob)
+	assert.False(t, ok)
+	assert.Equal(t, []string{
+		`metric "requests": metricdata.Sum[int64] is not a sum or gauge of float64`,
+	}, rt.errs)
+
+	temperature, _ := FindMetric(t, rm, "temperature")
+	gauge, ok := FindDataPoint[float64](t, temperature)
+	assert.True(t, ok)
+	assert.Equal(t, 21.5, gauge.Value)
+}
+
+func TestFindHistogramDataPoint(t *testing.T) {
+	rm := testResourceMetrics()
+	latency, _ := FindMetric(t, rm, "latency")
+
+	dp, ok := FindHistogramDataPoint[float64](t, latency, alice)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(4), dp.Count)
+
+	rt := new(recordingT)
+	_, ok = FindHistogramDataPoint[int64](rt, latency, alice)
+	assert.False(t, ok)
+	assert.Equal(t, []string{
+		`metric "latency": metricdata.Histogram[float64] is not a histogram of int64`,
+	}, rt.errs)
+
+	size, _ := FindMetric(t, rm, "size")
+	expoDP, ok := FindExponentialHistogramDataPoint[int64](t, size)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), expoDP.Count)
+
+	rt = new(recordingT)
+	_, ok = FindExponentialHistogramDataPoint[int64](rt, size, bob)
+	assert.False(t, ok)
+	want := "metric \"size\": no data point with attributes {user=bob}, data points have attributes:\n\t{}"
+	assert.Equal(t, []string{want}, rt.errs)
+}
+
+func TestValues(t *testing.T) {
+	rm := testResourceMetrics()
+
+	assert.Equal(t, int64(3), SumValue[int64](t, rm, "requests", alice))
+	assert.Equal(t, 21.5, GaugeValue[float64](t, rm, "temperature"))
+	assert.Equal(t, uint64(4), HistogramCount(t, rm, "latency", alice))
+	assert.Equal(t, uint64(2), HistogramCount(t, rm, "size"))
+
+	rt := new(recordingT)
+	assert.Zero(t, GaugeValue[int64](rt, rm, "requests", alice))
+	assert.Equal(t, []string{
+		`metric "requests": metricdata.Sum[int64] is not a metricdata.Gauge[int64]`,
+	}, rt.errs)
+
+	rt = new(recordingT)
+	assert.Zero(t, HistogramCount(rt, rm, "temperature"))
+	assert.Equal(t, []string{
+		`metric "temperature": metricdata.Gauge[float64] is not a histogram`,
+	}, rt.errs)
+
+	rt = new(recordingT)
+	assert.Zero(t, SumValue[int64](rt, rm, "unknown"))
+	assert.Len(t, rt.errs, 1)
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrictest is a testing helper package for the metric SDK. It
+// provides an in-memory reader to collect metric data on demand and helpers to
+// query and assert the collected data without comparing whole structures.
+package metrictest
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var _ metric.Reader = (*InMemoryReader)(nil)
+
+// NewInMemoryReader returns a new InMemoryReader configured with opts.
+func NewInMemoryReader(opts ...metric.ManualReaderOption) *InMemoryReader {
+	return &InMemoryReader{ManualReader: metric.NewManualReader(opts...)}
+}
+
+// InMemoryReader is a [metric.Reader] that collects metric data in-memory on
+// demand.
+type InMemoryReader struct {
+	*metric.ManualReader
+}
+
+// GetMetrics collects and returns the current metric data of the
+// MeterProvider the reader is registered with.
+func (r *InMemoryReader) GetMetrics(ctx context.Context) (metricdata.ResourceMetrics, error) {
+	var rm metricdata.ResourceMetrics
+	err := r.Collect(ctx, &rm)
+	return rm, err
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrictest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestInMemoryReader(t *testing.T) {
+	r := NewInMemoryReader(sdkmetric.WithTemporalitySelector(func(sdkmetric.InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	}))
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(r))
+	counter, err := mp.Meter("TestInMemoryReader").Int64Counter("requests")
+	require.NoError(t, err)
+
+	counter.Add(t.Context(), 2, metric.WithAttributes(alice))
+	rm, err := r.GetMetrics(t.Context())
+	require.NoError(t, err)
+	AssertSumValue[int64](t, rm, "requests", 2, alice)
+
+	// Each call collects on demand, the delta temporality is honored.
+	counter.Add(t.Context(), 5, metric.WithAttributes(alice))
+	rm, err = r.GetMetrics(t.Context())
+	require.NoError(t, err)
+	AssertSumValue[int64](t, rm, "requests", 5, alice)
+
+	require.NoError(t, mp.Shutdown(t.Context()))
+	_, err = r.GetMetrics(t.Context())
+	assert.ErrorIs(t, err, sdkmetric.ErrReaderShutdown)
+}
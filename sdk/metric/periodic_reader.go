@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,6 +30,8 @@ const (
 type periodicReaderConfig struct {
 	interval                 time.Duration
 	timeout                  time.Duration
+	align                    bool
+	jitter                   time.Duration
 	producers                []Producer
 	cardinalityLimitSelector CardinalityLimitSelector
 }
@@ -99,6 +102,39 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithIntervalAlignment configures a PeriodicReader to collect and export at
+// wall-clock times that are multiples of its interval since the Unix epoch.
+// For example, with an interval of one minute collections happen at the
+// start of every minute. This aligns the data points reported by different
+// processes using the same interval.
+//
+// ForceFlush does not change the alignment of the following collections.
+func WithIntervalAlignment() PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.align = true
+		return conf
+	})
+}
+
+// WithJitter configures a PeriodicReader to delay each periodic collection
+// and export, including the first, by a random duration in the range [0, d).
+// This spreads the export load of many processes started at the same time.
+// The jitter is not accumulated, the average time between collections remains
+// the interval.
+//
+// If d is greater than the interval, the interval is used instead. If this
+// option is not used or d is less than or equal to zero, no jitter is
+// applied.
+func WithJitter(d time.Duration) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if d <= 0 {
+			return conf
+		}
+		conf.jitter = d
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel any attempts that
@@ -134,6 +170,10 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 
 	go func() {
 		defer func() { close(r.done) }()
+		if conf.align || conf.jitter > 0 {
+			r.runScheduled(ctx, newSchedule(conf.interval, conf.align, conf.jitter, time.Now()))
+			return
+		}
 		r.run(ctx, conf.interval)
 	}()
 
@@ -211,6 +251,107 @@ func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
 	}
 }
 
+// runScheduled continuously collects and exports metric data at the times
+// determined by s. This will run until ctx is canceled or times out.
+func (r *PeriodicReader) runScheduled(ctx context.Context, s *schedule) {
+	timer := time.NewTimer(time.Until(s.next(time.Now())))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			err := r.collectAndExport(ctx)
+			if err != nil {
+				otel.Handle(err)
+			}
+			timer.Reset(time.Until(s.next(time.Now())))
+		case errCh := <-r.flushCh:
+			errCh <- r.collectAndExport(ctx)
+			timer.Reset(time.Until(s.flushed(time.Now())))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// schedule determines when a PeriodicReader collects and exports if it is
+// aligned to the wall-clock or jittered.
+type schedule struct {
+	interval time.Duration
+	align    bool
+	jitter   time.Duration
+	// randN returns a random duration in the range [0, n).
+	randN func(n int64) int64
+
+	// base is the collection time before any jitter is applied.
+	base time.Time
+}
+
+func newSchedule(interval time.Duration, align bool, jitter time.Duration, now time.Time) *schedule {
+	s := &schedule{
+		interval: interval,
+		align:    align,
+		jitter:   min(jitter, interval),
+		randN:    rand.Int64N,
+		base:     now,
+	}
+	if align {
+		// Start from the last boundary so the first collection is at the
+		// next one.
+		s.base = s.boundary(now).Add(-interval)
+	}
+	return s
+}
+
+// next returns the time of the collection that follows now.
+func (s *schedule) next(now time.Time) time.Time {
+	if s.align {
+		s.base = s.base.Add(s.interval)
+		if !s.base.Add(s.jitter).After(now) {
+			// The collection took longer than the interval, or the process
+			// was suspended. Do not try to catch up. The jitter of the
+			// previous collection alone does not skip a boundary.
+			s.base = s.boundary(now)
+		}
+	} else {
+		s.base = s.base.Add(s.interval)
+		if !s.base.After(now) {
+			// The collection took longer than the interval, or the process
+			// was suspended. Do not try to catch up.
+			s.base = now.Add(s.interval)
+		}
+	}
+	return s.jittered()
+}
+
+// flushed returns the time of the next collection after a ForceFlush at now.
+// An aligned schedule is not changed, otherwise the interval restarts.
+func (s *schedule) flushed(now time.Time) time.Time {
+	if s.align {
+		return s.jittered()
+	}
+	s.base = now.Add(s.interval)
+	return s.jittered()
+}
+
+// boundary returns the first multiple of the interval since the Unix epoch
+// that is after t.
+func (s *schedule) boundary(t time.Time) time.Time {
+	rem := time.Duration(t.UnixNano() % int64(s.interval))
+	if rem < 0 {
+		rem += s.interval
+	}
+	return t.Add(s.interval - rem)
+}
+
+// jittered returns the base time delayed by a random jitter.
+func (s *schedule) jittered() time.Time {
+	if s.jitter <= 0 {
+		return s.base
+	}
+	return s.base.Add(time.Duration(s.randN(int64(s.jitter))))
+}
+
 // register registers p as the producer of this reader.
 func (r *PeriodicReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
@@ -220,6 +361,12 @@ func (r *PeriodicReader) register(p sdkProducer) {
 	}
 }
 
+// instrumentation returns the self-observability instrumentation of r,
+// nil if it is not enabled.
+func (r *PeriodicReader) instrumentation() *observ.Instrumentation {
+	return r.inst
+}
+
 // temporality reports the Temporality for the instrument kind provided.
 func (r *PeriodicReader) temporality(kind InstrumentKind) metricdata.Temporality {
 	return r.exporter.Temporality(kind)
@@ -20,8 +20,10 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -99,6 +101,105 @@ func TestWithInterval(t *testing.T) {
 	assert.Equal(t, defaultInterval, test(time.Duration(-1)), "invalid interval should use default")
 }
 
+func TestWithIntervalAlignment(t *testing.T) {
+	assert.False(t, newPeriodicReaderConfig(nil).align)
+	assert.True(t, newPeriodicReaderConfig([]PeriodicReaderOption{WithIntervalAlignment()}).align)
+}
+
+func TestWithJitter(t *testing.T) {
+	test := func(d time.Duration) time.Duration {
+		opts := []PeriodicReaderOption{WithJitter(d)}
+		return newPeriodicReaderConfig(opts).jitter
+	}
+
+	assert.Equal(t, testDur, test(testDur))
+	assert.Equal(t, time.Duration(0), newPeriodicReaderConfig(nil).jitter)
+	assert.Equal(t, time.Duration(0), test(time.Duration(-1)), "invalid jitter should not be used")
+}
+
+func TestSchedule(t *testing.T) {
+	start := time.Date(2000, time.January, 1, 0, 0, 12, 0, time.UTC)
+	at := func(sec int) time.Time { return start.Truncate(time.Minute).Add(time.Duration(sec) * time.Second) }
+	half := func(n int64) int64 { return n / 2 }
+
+	t.Run("Interval", func(t *testing.T) {
+		s := newSchedule(time.Minute, false, 0, start)
+		assert.Equal(t, at(72), s.next(start))
+		assert.Equal(t, at(132), s.next(at(73)), "collection time counted towards the interval")
+		assert.Equal(t, at(260), s.next(at(200)), "overrun collections not caught up")
+		assert.Equal(t, at(150), s.flushed(at(90)), "flush did not restart the interval")
+	})
+
+	t.Run("Aligned", func(t *testing.T) {
+		s := newSchedule(time.Minute, true, 0, start)
+		assert.Equal(t, at(60), s.next(start))
+		assert.Equal(t, at(120), s.next(at(60)))
+		assert.Equal(t, at(120), s.flushed(at(90)), "flush changed alignment")
+		assert.Equal(t, at(240), s.next(at(181)), "overrun collections not caught up")
+	})
+
+	t.Run("Jitter", func(t *testing.T) {
+		s := newSchedule(time.Minute, false, 10*time.Second, start)
+		s.randN = half
+		assert.Equal(t, at(77), s.next(start))
+		assert.Equal(t, at(137), s.next(at(77)), "jitter accumulated")
+	})
+
+	t.Run("AlignedJitter", func(t *testing.T) {
+		s := newSchedule(time.Minute, true, 10*time.Second, start)
+		s.randN = half
+		assert.Equal(t, at(65), s.next(start))
+		assert.Equal(t, at(125), s.next(at(65)))
+		assert.Equal(t, at(185), s.next(at(119)), "clock before boundary collected twice")
+	})
+
+	t.Run("AlignedJitterSlowCollection", func(t *testing.T) {
+		s := newSchedule(time.Minute, true, time.Minute, start)
+		s.randN = func(int64) int64 { return int64(59 * time.Second) }
+		assert.Equal(t, at(119), s.next(start))
+		// The collection at 119s took 2s.
+		assert.Equal(t, at(179), s.next(at(121)), "boundary skipped")
+		// The process was suspended past the jitter of the next boundary.
+		assert.Equal(t, at(359), s.next(at(241)), "overrun collections not caught up")
+	})
+
+	t.Run("JitterLimitedToInterval", func(t *testing.T) {
+		s := newSchedule(time.Minute, false, time.Hour, start)
+		assert.Equal(t, time.Minute, s.jitter)
+	})
+}
+
+func TestPeriodicReaderScheduled(t *testing.T) {
+	exported := make(chan struct{}, 2)
+	exp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			select {
+			case exported <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	}
+
+	r := NewPeriodicReader(
+		exp,
+		WithInterval(10*time.Millisecond),
+		WithIntervalAlignment(),
+		WithJitter(5*time.Millisecond),
+	)
+	r.register(testSDKProducer{})
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+	require.NoError(t, r.ForceFlush(t.Context()))
+	for range 2 {
+		select {
+		case <-exported:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "periodic export not run")
+		}
+	}
+}
+
 func TestIntervalEnvVar(t *testing.T) {
 	testCases := []struct {
 		v    string
@@ -820,6 +921,50 @@ func TestPeriodicReaderInstrumentation(t *testing.T) {
 	assert.True(t, hasType, "expected otel.component.type == %q", expectedComponentType)
 }
 
+func TestPeriodicReaderCallbackTimeoutInstrumentation(t *testing.T) {
+	// Enable SDK observability.
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+
+	orig := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(orig) })
+	origEH := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(origEH) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(error) {}))
+
+	instrumentationReader := NewManualReader()
+	instrumentationMP := NewMeterProvider(WithReader(instrumentationReader))
+	otel.SetMeterProvider(instrumentationMP)
+	t.Cleanup(func() { _ = instrumentationMP.Shutdown(t.Context()) })
+
+	r := NewPeriodicReader(&fnExporter{})
+	mp := NewMeterProvider(WithReader(r), WithCallbackTimeout(time.Millisecond))
+	t.Cleanup(func() { _ = mp.Shutdown(t.Context()) })
+
+	release := make(chan struct{})
+	_, err := mp.Meter("test").Int64ObservableGauge("slow", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error {
+			<-release
+			return nil
+		},
+	))
+	require.NoError(t, err)
+	require.NoError(t, r.ForceFlush(t.Context()))
+	close(release)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, instrumentationReader.Collect(t.Context(), &rm))
+	m := findMetricByName(&rm, observ.CallbackFailedName)
+	require.NotNil(t, m, "callback failed metric not recorded")
+
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	require.True(t, ok, "expected int64 sum data")
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+	v, ok := sum.DataPoints[0].Attributes.Value(semconv.ErrorTypeKey)
+	require.True(t, ok, "missing error type attribute")
+	assert.Equal(t, "timeout", v.AsString())
+}
+
 func TestPeriodicReaderInstrumentationError(t *testing.T) {
 	// Enable SDK observability.
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
@@ -877,6 +1022,10 @@ func (m *errMeter) Float64Histogram(string, ...metric.Float64HistogramOption) (m
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Counter(string, ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return nil, m.err
+}
+
 // createMetricDataTestProducer creates a producer using patterns from metricdatatest.
 func createMetricDataTestProducer() testSDKProducer {
 	return testSDKProducer{
@@ -75,9 +75,11 @@ type pipeline struct {
 	int64Measures    map[observableID[int64]][]aggregate.Measure[int64]
 	float64Measures  map[observableID[float64]][]aggregate.Measure[float64]
 	aggregations     map[instrumentation.Scope][]instrumentSync
-	callbacks        []func(context.Context) error
+	callbacks        []callback
 	multiCallbacks   list.List
+	callbackConfig   callbackConfig
 	exemplarFilter   exemplar.Filter
+	measFilter       exemplar.MeasurementFilter
 	cardinalityLimit int
 }
 
@@ -110,11 +112,12 @@ func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 	p.aggregations[scope] = append(p.aggregations[scope], iSync)
 }
 
-type multiCallback func(context.Context) error
-
 // addMultiCallback registers a multi-instrument callback to be run when
 // `produce()` is called.
-func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
+func (p *pipeline) addMultiCallback(c callback) (unregister func()) {
+	if p.callbackConfig.timeout > 0 {
+		c = singleFlight(c)
+	}
 	p.Lock()
 	defer p.Unlock()
 	e := p.multiCallbacks.PushBack(c)
@@ -140,20 +143,7 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	p.Lock()
 	defer p.Unlock()
 
-	var err error
-	for _, c := range p.callbacks {
-		// TODO make the callbacks parallel. ( #3034 )
-		if e := c(ctx); e != nil {
-			err = errors.Join(err, e)
-		}
-	}
-	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
-		// TODO make the callbacks parallel. ( #3034 )
-		f := e.Value.(multiCallback)
-		if e := f(ctx); e != nil {
-			err = errors.Join(err, e)
-		}
-	}
+	err := p.runCallbacks(ctx)
 
 	rm.Resource = p.resource
 	rm.ScopeMetrics = internal.ReuseSlice(rm.ScopeMetrics, len(p.aggregations))
@@ -218,7 +208,9 @@ func newInserter[N int64 | float64](p *pipeline, vc *cache[string, instID]) *ins
 // Instrument inserts the instrument inst with instUnit into a pipeline. All
 // views the pipeline contains are matched against, and any matching view that
 // creates a unique aggregate function will have its output inserted into the
-// pipeline and its input included in the returned slice.
+// pipeline and its input included in the returned slice. The function
+// binding each input to an attribute set is returned at the same index of the
+// second returned slice.
 //
 // The returned aggregate function inputs are ensured to be deduplicated and
 // unique. If another view in another pipeline that is cached by this
@@ -241,10 +233,11 @@ func (i *inserter[N]) Instrument(
 	inst Instrument,
 	allowedKeys []attribute.Key,
 	readerAggregation Aggregation,
-) ([]aggregate.Measure[N], error) {
+) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
 	var (
 		matched  bool
 		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
 	)
 
 	var err error
@@ -255,7 +248,7 @@ func (i *inserter[N]) Instrument(
 			continue
 		}
 		matched = true
-		in, id, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+		in, bind, id, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 		if e != nil {
 			err = errors.Join(err, e)
 		}
@@ -268,6 +261,7 @@ func (i *inserter[N]) Instrument(
 		}
 		seen[id] = struct{}{}
 		measures = append(measures, in)
+		binds = append(binds, bind)
 	}
 
 	if err != nil {
@@ -275,7 +269,7 @@ func (i *inserter[N]) Instrument(
 	}
 
 	if matched {
-		return measures, err
+		return measures, binds, err
 	}
 
 	// Apply implicit default view if no explicit matched.
@@ -290,7 +284,7 @@ func (i *inserter[N]) Instrument(
 	if allowedKeys != nil {
 		stream.AttributeFilter = attribute.NewAllowKeysFilter(allowedKeys...)
 	}
-	in, _, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+	in, bind, _, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 	if e != nil {
 		if err == nil {
 			err = errCreatingAggregators
@@ -300,13 +294,17 @@ func (i *inserter[N]) Instrument(
 	if in != nil {
 		// Ensured to have not seen given matched was false.
 		measures = append(measures, in)
+		binds = append(binds, bind)
 	}
-	return measures, err
+	return measures, binds, err
 }
 
 // addCallback registers a single instrument callback to be run when
 // `produce()` is called.
-func (i *inserter[N]) addCallback(cback func(context.Context) error) {
+func (i *inserter[N]) addCallback(cback callback) {
+	if i.pipeline.callbackConfig.timeout > 0 {
+		cback = singleFlight(cback)
+	}
 	i.pipeline.Lock()
 	defer i.pipeline.Unlock()
 	i.pipeline.callbacks = append(i.pipeline.callbacks, cback)
@@ -318,6 +316,7 @@ var aggIDCount atomic.Uint64
 type aggVal[N int64 | float64] struct {
 	ID      uint64
 	Measure aggregate.Measure[N]
+	Bind    aggregate.Bind[N]
 	Err     error
 }
 
@@ -346,7 +345,7 @@ func (i *inserter[N]) readerDefaultAggregation(kind InstrumentKind) Aggregation
 	return aggregation
 }
 
-// cachedAggregator returns the appropriate aggregate input and output
+// cachedAggregator returns the appropriate aggregate input, bind, and output
 // functions for an instrument configuration. If the exact instrument has been
 // created within the inst.Scope, those aggregate function instances will be
 // returned. Otherwise, new computed aggregate functions will be cached and
@@ -365,7 +364,7 @@ func (i *inserter[N]) cachedAggregator(
 	kind InstrumentKind,
 	stream Stream,
 	readerAggregation Aggregation,
-) (meas aggregate.Measure[N], aggID uint64, err error) {
+) (meas aggregate.Measure[N], bind aggregate.Bind[N], aggID uint64, err error) {
 	switch stream.Aggregation.(type) {
 	case nil:
 		// The aggregation was not overridden with a view. Use the aggregation
@@ -380,7 +379,7 @@ func (i *inserter[N]) cachedAggregator(
 	}
 
 	if err := isAggregatorCompatible(kind, stream.Aggregation); err != nil {
-		return nil, 0, fmt.Errorf(
+		return nil, nil, 0, fmt.Errorf(
 			"creating aggregator with instrumentKind: %d, aggregation %v: %w",
 			kind, stream.Aggregation, err,
 		)
@@ -402,18 +401,21 @@ func (i *inserter[N]) cachedAggregator(
 				kind,
 				stream.ExemplarReservoirProviderSelector(stream.Aggregation),
 				i.pipeline.exemplarFilter,
+				i.pipeline.measFilter,
 			),
 		}
 		b.Filter = stream.AttributeFilter
 		// A value less than or equal to zero will disable the aggregation
 		// limits for the builder (an all the created aggregates).
 		b.AggregationLimit = i.getCardinalityLimit(kind)
+		var bind aggregate.Bind[N]
+		b.Bind = &bind
 		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
-			return aggVal[N]{0, nil, err}
+			return aggVal[N]{0, nil, nil, err}
 		}
 		if in == nil { // Drop aggregator.
-			return aggVal[N]{0, nil, nil}
+			return aggVal[N]{0, nil, nil, nil}
 		}
 		i.pipeline.addSync(scope, instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
@@ -424,9 +426,9 @@ func (i *inserter[N]) cachedAggregator(
 			compAgg:     out,
 		})
 		id := aggIDCount.Add(1)
-		return aggVal[N]{id, in, err}
+		return aggVal[N]{id, in, bind, err}
 	})
-	return cv.Measure, cv.ID, cv.Err
+	return cv.Measure, cv.Bind, cv.ID, cv.Err
 }
 
 // getCardinalityLimit returns the cardinality limit for the given instrument kind.
@@ -558,6 +560,8 @@ func (i *inserter[N]) aggregateFunc(
 			noSum = true
 		}
 		meas, comp = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
+	case AggregationSummary:
+		meas, comp = b.Summary(a.Quantiles, a.relativeAccuracy(), a.maxSize())
 
 	default:
 		err = errUnknownAggregation
@@ -569,15 +573,15 @@ func (i *inserter[N]) aggregateFunc(
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
 // Current compatibility:
 //
-// | Instrument Kind          | Drop | LastValue | Sum | Histogram | Exponential Histogram |
-// |--------------------------|------|-----------|-----|-----------|-----------------------|
-// | Counter                  | ✓    |           | ✓   | ✓         | ✓                     |
-// | UpDownCounter            | ✓    |           | ✓   | ✓         | ✓                     |
-// | Histogram                | ✓    |           | ✓   | ✓         | ✓                     |
-// | Gauge                    | ✓    | ✓         |     | ✓         | ✓                     |
-// | Observable Counter       | ✓    |           | ✓   | ✓         | ✓                     |
-// | Observable UpDownCounter | ✓    |           | ✓   | ✓         | ✓                     |
-// | Observable Gauge         | ✓    | ✓         |     | ✓         | ✓                     |.
+// | Instrument Kind          | Drop | LastValue | Sum | Histogram | Exponential Histogram | Summary |
+// |--------------------------|------|-----------|-----|-----------|-----------------------|---------|
+// | Counter                  | ✓    |           | ✓   | ✓         | ✓                     | ✓       |
+// | UpDownCounter            | ✓    |           | ✓   | ✓         | ✓                     |         |
+// | Histogram                | ✓    |           | ✓   | ✓         | ✓                     | ✓       |
+// | Gauge                    | ✓    | ✓         |     | ✓         | ✓                     |         |
+// | Observable Counter       | ✓    |           | ✓   | ✓         | ✓                     |         |
+// | Observable UpDownCounter | ✓    |           | ✓   | ✓         | ✓                     |         |
+// | Observable Gauge         | ✓    | ✓         |     | ✓         | ✓                     |         |.
 func isAggregatorCompatible(kind InstrumentKind, agg Aggregation) error {
 	switch agg.(type) {
 	case AggregationDefault:
@@ -616,6 +620,14 @@ func isAggregatorCompatible(kind InstrumentKind, agg Aggregation) error {
 		// TODO: review need for aggregation check after
 		// https://github.com/open-telemetry/opentelemetry-specification/issues/2710
 		return errIncompatibleAggregation
+	case AggregationSummary:
+		switch kind {
+		case InstrumentKindCounter, InstrumentKindHistogram:
+			return nil
+		}
+		// Summaries of precomputed or possibly negative values are not
+		// meaningful.
+		return errIncompatibleAggregation
 	case AggregationDrop:
 		return nil
 	default:
@@ -633,11 +645,15 @@ func newPipelines(
 	readers []Reader,
 	views []View,
 	exemplarFilter exemplar.Filter,
+	measFilter exemplar.MeasurementFilter,
 	cardinalityLimit int,
+	cbConf callbackConfig,
 ) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
 	for _, r := range readers {
 		p := newPipeline(res, r, views, exemplarFilter, cardinalityLimit)
+		p.measFilter = measFilter
+		p.callbackConfig = cbConf
 		r.register(p)
 		pipes = append(pipes, p)
 	}
@@ -672,30 +688,41 @@ func newResolver[N int64 | float64](p pipelines, vc *cache[string, instID]) reso
 }
 
 // Aggregators returns the Aggregators that must be updated by the instrument
-// defined by key.
-func (r resolver[N]) Aggregators(id Instrument, allowedKeys []attribute.Key) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+// defined by key, and the functions that bind them to an attribute set.
+func (r resolver[N]) Aggregators(
+	id Instrument,
+	allowedKeys []attribute.Key,
+) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
+	var (
+		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
+	)
 
 	var err error
 	for _, i := range r.inserters {
-		in, e := i.Instrument(id, allowedKeys, i.readerDefaultAggregation(id.Kind))
+		in, bind, e := i.Instrument(id, allowedKeys, i.readerDefaultAggregation(id.Kind))
 		if e != nil {
 			err = errors.Join(err, e)
 		}
 		measures = append(measures, in...)
+		binds = append(binds, bind...)
 	}
-	return measures, err
+	return measures, binds, err
 }
 
 // HistogramAggregators returns the histogram Aggregators that must be updated by the instrument
-// defined by key. If boundaries were provided on instrument instantiation, those take precedence
-// over boundaries provided by the reader.
+// defined by key, and the functions that bind them to an attribute set. If boundaries were
+// provided on instrument instantiation, those take precedence over boundaries provided by the
+// reader.
 func (r resolver[N]) HistogramAggregators(
 	id Instrument,
 	allowedKeys []attribute.Key,
 	boundaries []float64,
-) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
+	var (
+		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
+	)
 
 	var err error
 	for _, i := range r.inserters {
@@ -704,11 +731,12 @@ func (r resolver[N]) HistogramAggregators(
 			histAgg.Boundaries = boundaries
 			agg = histAgg
 		}
-		in, e := i.Instrument(id, allowedKeys, agg)
+		in, bind, e := i.Instrument(id, allowedKeys, agg)
 		if e != nil {
 			err = errors.Join(err, e)
 		}
 		measures = append(measures, in...)
+		binds = append(binds, bind...)
 	}
-	return measures, err
+	return measures, binds, err
 }
@@ -394,7 +394,7 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 			p := newPipeline(nil, tt.reader, tt.views, exemplar.AlwaysOffFilter, 0)
 			i := newInserter[N](p, &c)
 			readerAggregation := i.readerDefaultAggregation(tt.inst.Kind)
-			input, err := i.Instrument(tt.inst, nil, readerAggregation)
+			input, _, err := i.Instrument(tt.inst, nil, readerAggregation)
 			var comps []aggregate.ComputeAggregation
 			for _, instSyncs := range p.aggregations {
 				for _, i := range instSyncs {
@@ -419,7 +419,7 @@ func testInvalidInstrumentShouldPanic[N int64 | float64]() {
 		Kind: InstrumentKind(255),
 	}
 	readerAggregation := i.readerDefaultAggregation(inst.Kind)
-	_, _ = i.Instrument(inst, nil, readerAggregation)
+	_, _, _ = i.Instrument(inst, nil, readerAggregation)
 }
 
 func TestInvalidInstrumentShouldPanic(t *testing.T) {
@@ -429,13 +429,13 @@ func TestInvalidInstrumentShouldPanic(t *testing.T) {
 
 func TestPipelinesAggregatorForEachReader(t *testing.T) {
 	r0, r1 := NewManualReader(), NewManualReader()
-	pipes := newPipelines(resource.Empty(), []Reader{r0, r1}, nil, exemplar.AlwaysOffFilter, 0)
+	pipes := newPipelines(resource.Empty(), []Reader{r0, r1}, nil, exemplar.AlwaysOffFilter, nil, 0, callbackConfig{})
 	require.Len(t, pipes, 2, "created pipelines")
 
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[int64](pipes, &c)
-	aggs, err := r.Aggregators(inst, nil)
+	aggs, _, err := r.Aggregators(inst, nil)
 	require.NoError(t, err, "resolved Aggregators error")
 	require.Len(t, aggs, 2, "instrument aggregators")
 
@@ -503,7 +503,15 @@ func TestPipelineRegistryCreateAggregators(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			p := newPipelines(resource.Empty(), tt.readers, tt.views, exemplar.AlwaysOffFilter, 0)
+			p := newPipelines(
+				resource.Empty(),
+				tt.readers,
+				tt.views,
+				exemplar.AlwaysOffFilter,
+				nil,
+				0,
+				callbackConfig{},
+			)
 			testPipelineRegistryResolveIntAggregators(t, p, tt.wantCount)
 			testPipelineRegistryResolveFloatAggregators(t, p, tt.wantCount)
 			testPipelineRegistryResolveIntHistogramAggregators(t, p, tt.wantCount)
@@ -516,7 +524,7 @@ func testPipelineRegistryResolveIntAggregators(t *testing.T, p pipelines, wantCo
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[int64](p, &c)
-	aggs, err := r.Aggregators(inst, nil)
+	aggs, _, err := r.Aggregators(inst, nil)
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -526,7 +534,7 @@ func testPipelineRegistryResolveFloatAggregators(t *testing.T, p pipelines, want
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[float64](p, &c)
-	aggs, err := r.Aggregators(inst, nil)
+	aggs, _, err := r.Aggregators(inst, nil)
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -536,7 +544,7 @@ func testPipelineRegistryResolveIntHistogramAggregators(t *testing.T, p pipeline
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[int64](p, &c)
-	aggs, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	aggs, _, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3})
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -546,7 +554,7 @@ func testPipelineRegistryResolveFloatHistogramAggregators(t *testing.T, p pipeli
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[float64](p, &c)
-	aggs, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	aggs, _, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3})
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -557,7 +565,7 @@ func TestPipelineRegistryResource(t *testing.T) {
 	readers := []Reader{NewManualReader()}
 	views := []View{defaultView, v}
 	res := resource.NewSchemaless(attribute.String("key", "val"))
-	pipes := newPipelines(res, readers, views, exemplar.AlwaysOffFilter, 0)
+	pipes := newPipelines(res, readers, views, exemplar.AlwaysOffFilter, nil, 0, callbackConfig{})
 	for _, p := range pipes {
 		assert.True(t, res.Equal(p.resource), "resource not set")
 	}
@@ -570,25 +578,25 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 
 	readers := []Reader{testRdrHistogram}
 	views := []View{defaultView}
-	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, 0)
+	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, nil, 0, callbackConfig{})
 	inst := Instrument{Name: "foo", Kind: InstrumentKindObservableGauge}
 
 	var vc cache[string, instID]
 	ri := newResolver[int64](p, &vc)
-	intAggs, err := ri.Aggregators(inst, nil)
+	intAggs, _, err := ri.Aggregators(inst, nil)
 	assert.Error(t, err)
 	assert.Empty(t, intAggs)
 
 	rf := newResolver[float64](p, &vc)
-	floatAggs, err := rf.Aggregators(inst, nil)
+	floatAggs, _, err := rf.Aggregators(inst, nil)
 	assert.Error(t, err)
 	assert.Empty(t, floatAggs)
 
-	intAggs, err = ri.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	intAggs, _, err = ri.HistogramAggregators(inst, nil, []float64{1, 2, 3})
 	assert.Error(t, err)
 	assert.Empty(t, intAggs)
 
-	floatAggs, err = rf.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	floatAggs, _, err = rf.HistogramAggregators(inst, nil, []float64{1, 2, 3})
 	assert.Error(t, err)
 	assert.Empty(t, floatAggs)
 }
@@ -630,18 +638,18 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	fooInst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	barInst := Instrument{Name: "bar", Kind: InstrumentKindCounter}
 
-	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, 0)
+	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, nil, 0, callbackConfig{})
 
 	var vc cache[string, instID]
 	ri := newResolver[int64](p, &vc)
-	intAggs, err := ri.Aggregators(fooInst, nil)
+	intAggs, _, err := ri.Aggregators(fooInst, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
 	assert.Len(t, intAggs, 1)
 
 	// The Rename view should produce the same instrument without an error, the
 	// default view should also cause a new aggregator to be returned.
-	intAggs, err = ri.Aggregators(barInst, nil)
+	intAggs, _, err = ri.Aggregators(barInst, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
 	assert.Len(t, intAggs, 2)
@@ -649,19 +657,19 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	// Creating a float foo instrument should log a warning because there is an
 	// int foo instrument.
 	rf := newResolver[float64](p, &vc)
-	floatAggs, err := rf.Aggregators(fooInst, nil)
+	floatAggs, _, err := rf.Aggregators(fooInst, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, l.InfoN(), "instrument conflict not logged")
 	assert.Len(t, floatAggs, 1)
 
 	fooInst = Instrument{Name: "foo-float", Kind: InstrumentKindCounter}
 
-	floatAggs, err = rf.Aggregators(fooInst, nil)
+	floatAggs, _, err = rf.Aggregators(fooInst, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
 	assert.Len(t, floatAggs, 1)
 
-	floatAggs, err = rf.Aggregators(barInst, nil)
+	floatAggs, _, err = rf.Aggregators(barInst, nil)
 	assert.NoError(t, err)
 	// Both the rename and default view aggregators created above should now
 	// conflict. Therefore, 2 warning messages should be logged.
@@ -884,6 +892,34 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  AggregationBase2ExponentialHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "SyncCounter and Summary",
+			kind: InstrumentKindCounter,
+			agg:  AggregationSummary{},
+		},
+		{
+			name: "SyncHistogram and Summary",
+			kind: InstrumentKindHistogram,
+			agg:  AggregationSummary{},
+		},
+		{
+			name: "SyncUpDownCounter and Summary",
+			kind: InstrumentKindUpDownCounter,
+			agg:  AggregationSummary{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncGauge and Summary",
+			kind: InstrumentKindGauge,
+			agg:  AggregationSummary{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "ObservableCounter and Summary",
+			kind: InstrumentKindObservableCounter,
+			agg:  AggregationSummary{},
+			want: errIncompatibleAggregation,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -58,7 +58,7 @@ func TestNewPipeline(t *testing.T) {
 	})
 
 	require.NotPanics(t, func() {
-		pipe.addMultiCallback(func(context.Context) error { return nil })
+		pipe.addMultiCallback(func(context.Context, *callbackGuard) error { return nil })
 	})
 
 	err = pipe.produce(t.Context(), &output)
@@ -99,7 +99,7 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 		}(i)
 
 		wg.Go(func() {
-			pipe.addMultiCallback(func(context.Context) error { return nil })
+			pipe.addMultiCallback(func(context.Context, *callbackGuard) error { return nil })
 		})
 
 		wg.Go(func() {
@@ -153,7 +153,7 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 				var c cache[string, instID]
 				i := newInserter[N](test.pipe, &c)
 				readerAggregation := i.readerDefaultAggregation(inst.Kind)
-				got, err := i.Instrument(inst, nil, readerAggregation)
+				got, _, err := i.Instrument(inst, nil, readerAggregation)
 				require.NoError(t, err)
 				assert.Len(t, got, 1, "default view not applied")
 				for _, in := range got {
@@ -380,7 +380,7 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	i := newInserter[int64](pipe, &vc)
 
 	readerAggregation := i.readerDefaultAggregation(kind)
-	_, origID, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
+	_, _, origID, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
 	require.NoError(t, err)
 
 	require.Len(t, pipe.aggregations, 1)
@@ -390,7 +390,7 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	require.Equal(t, name, iSync[0].name)
 
 	stream.Name = "RequestCount"
-	_, id, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
+	_, _, id, err := i.cachedAggregator(scope, kind, stream, readerAggregation)
 	require.NoError(t, err)
 	assert.Equal(t, origID, id, "multiple aggregators for equivalent name")
 
@@ -645,7 +645,7 @@ func TestPipelineProduceErrors(t *testing.T) {
 
 	pipe.callbacks = append(pipe.callbacks,
 		// Callback 1: cancels the context during execution but continues to populate data
-		func(ctx context.Context) error {
+		func(ctx context.Context, _ *callbackGuard) error {
 			callbackCounts[0]++
 			for _, m := range pipe.int64Measures[testObsID] {
 				m(ctx, 123, *attribute.EmptySet())
@@ -653,7 +653,7 @@ func TestPipelineProduceErrors(t *testing.T) {
 			return nil
 		},
 		// Callback 2: populates int64 observable data
-		func(context.Context) error {
+		func(context.Context, *callbackGuard) error {
 			callbackCounts[1]++
 			if shouldCancelContext {
 				cancelCtx()
@@ -661,7 +661,7 @@ func TestPipelineProduceErrors(t *testing.T) {
 			return nil
 		},
 		// Callback 3: return an error
-		func(context.Context) error {
+		func(context.Context, *callbackGuard) error {
 			callbackCounts[2]++
 			if shouldReturnError {
 				return fmt.Errorf("test callback error")
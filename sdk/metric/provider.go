@@ -43,7 +43,15 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 	flush, sdown := conf.readerSignals()
 
 	mp := &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views, conf.exemplarFilter, conf.cardinalityLimit),
+		pipes: newPipelines(
+			conf.res,
+			conf.readers,
+			conf.views,
+			conf.exemplarFilter,
+			conf.measFilter,
+			conf.cardinalityLimit,
+			conf.callbacks,
+		),
 		forceFlush: flush,
 		shutdown:   sdown,
 	}
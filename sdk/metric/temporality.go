@@ -0,0 +1,511 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+)
+
+// NewTemporalityExporter returns an Exporter that converts metric data to the
+// temporality selected by exporter before it is exported.
+//
+// The returned Exporter reports the temporality selected by selector to the
+// Reader it is used with. This is the temporality the SDK aggregates with. If
+// selector is nil, DefaultTemporalitySelector is used. All Sum, Histogram, and
+// ExponentialHistogram data passed to Export that does not match the
+// temporality exporter selects is converted before it is passed to exporter.
+// This includes data from external Producers, such as bridges, that only
+// produce a single temporality.
+//
+// The instrument kind passed to the Temporality method of exporter is
+// inferred from the data: monotonic sums use InstrumentKindCounter,
+// non-monotonic sums use InstrumentKindUpDownCounter, and histograms use
+// InstrumentKindHistogram.
+//
+// Converting from cumulative to delta temporality uses the previously
+// exported value of a timeseries as the start of the next delta. A change in
+// the start time of a timeseries, or a decrease of a monotonic value, is
+// treated as a reset and the full cumulative value is exported. Converting
+// from delta to cumulative temporality accumulates all deltas of a timeseries
+// since its first delta. A delta that starts before the end of the previous
+// one is treated as a reset.
+//
+// The state of a timeseries is kept until it is no longer converted. A
+// cumulative timeseries is forgotten as soon as it is missing from a
+// conversion. A delta timeseries, which is only reported when it changes, is
+// forgotten once it is missing from 10 consecutive conversions. If it is
+// reported again afterwards, its accumulation restarts from that delta, with
+// its start time.
+func NewTemporalityExporter(exporter Exporter, selector TemporalitySelector) Exporter {
+	if selector == nil {
+		selector = DefaultTemporalitySelector
+	}
+	return &temporalityExporter{
+		exporter: exporter,
+		selector: selector,
+		conv:     newTemporalityConverter(exporter.Temporality),
+	}
+}
+
+// temporalityExporter is an Exporter that converts the temporality of metric
+// data before exporting it with a wrapped Exporter.
+type temporalityExporter struct {
+	exporter Exporter
+	selector TemporalitySelector
+	conv     *temporalityConverter
+}
+
+var _ Exporter = (*temporalityExporter)(nil)
+
+// Temporality returns the Temporality the SDK is to aggregate with.
+func (e *temporalityExporter) Temporality(k InstrumentKind) metricdata.Temporality {
+	return e.selector(k)
+}
+
+// Aggregation returns the Aggregation of the wrapped exporter.
+func (e *temporalityExporter) Aggregation(k InstrumentKind) Aggregation {
+	return e.exporter.Aggregation(k)
+}
+
+// Export converts rm to the temporality of the wrapped exporter and exports
+// it. The passed rm is not modified.
+func (e *temporalityExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	out := &metricdata.ResourceMetrics{
+		Resource:     rm.Resource,
+		ScopeMetrics: e.conv.convert(rm.ScopeMetrics),
+	}
+	return e.exporter.Export(ctx, out)
+}
+
+// ForceFlush flushes the wrapped exporter.
+func (e *temporalityExporter) ForceFlush(ctx context.Context) error {
+	return e.exporter.ForceFlush(ctx)
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *temporalityExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// NewTemporalityProducer returns a Producer that converts the Sum, Histogram,
+// and ExponentialHistogram data produced by producer to the temporality
+// selected by selector. If selector is nil, DefaultTemporalitySelector is used.
+//
+// The returned Producer keeps the state of every timeseries it converts. It
+// must only be registered with a single Reader, otherwise the converted data
+// of each Reader will be incomplete.
+//
+// See NewTemporalityExporter for details on how the instrument kind is
+// inferred and how the conversion is done.
+func NewTemporalityProducer(producer Producer, selector TemporalitySelector) Producer {
+	if selector == nil {
+		selector = DefaultTemporalitySelector
+	}
+	return &temporalityProducer{
+		producer: producer,
+		conv:     newTemporalityConverter(selector),
+	}
+}
+
+// temporalityProducer is a Producer that converts the temporality of the
+// metric data produced by a wrapped Producer.
+type temporalityProducer struct {
+	producer Producer
+	conv     *temporalityConverter
+}
+
+var _ Producer = (*temporalityProducer)(nil)
+
+// Produce returns the converted metric data of the wrapped Producer.
+func (p *temporalityProducer) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	sm, err := p.producer.Produce(ctx)
+	if len(sm) == 0 {
+		return sm, err
+	}
+	return p.conv.convert(sm), err
+}
+
+// streamID uniquely identifies a timeseries.
+type streamID struct {
+	scopeName      string
+	scopeVersion   string
+	scopeSchemaURL string
+	scopeAttrs     attribute.Distinct
+
+	name  string
+	attrs attribute.Distinct
+}
+
+// streamState is the conversion state of a timeseries.
+type streamState struct {
+	// input is the temporality of the data last seen for the timeseries.
+	input metricdata.Temporality
+	// seen is the number of the last conversion the timeseries was seen in.
+	seen uint64
+	// point is the last cumulative data point of the timeseries. For
+	// cumulative input this is the last input data point, for delta input
+	// this is the accumulated value of all deltas.
+	point any
+}
+
+// temporalityConverter converts metric data between temporalities.
+type temporalityConverter struct {
+	selector TemporalitySelector
+
+	// deltaStaleness is the number of consecutive conversions a delta
+	// timeseries can be missing from before its state is forgotten.
+	deltaStaleness uint64
+
+	mu      sync.Mutex
+	streams map[streamID]*streamState
+	// conversions is the number of the current conversion.
+	conversions uint64
+}
+
+// dfltDeltaStaleness is the default number of consecutive conversions a
+// delta timeseries can be missing from before its state is forgotten.
+const dfltDeltaStaleness = 10
+
+func newTemporalityConverter(selector TemporalitySelector) *temporalityConverter {
+	return &temporalityConverter{
+		selector:       selector,
+		deltaStaleness: dfltDeltaStaleness,
+		streams:        make(map[streamID]*streamState),
+	}
+}
+
+// convert returns a copy of sms with all data converted to the temporality of
+// c. The passed sms is not modified.
+func (c *temporalityConverter) convert(sms []metricdata.ScopeMetrics) []metricdata.ScopeMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conversions++
+	out := make([]metricdata.ScopeMetrics, len(sms))
+	for i, sm := range sms {
+		out[i] = metricdata.ScopeMetrics{
+			Scope:   sm.Scope,
+			Metrics: make([]metricdata.Metrics, len(sm.Metrics)),
+		}
+		for j, m := range sm.Metrics {
+			out[i].Metrics[j] = m
+			out[i].Metrics[j].Data = c.convertAggregation(sm.Scope, m.Name, m.Data)
+		}
+	}
+
+	// Cumulative timeseries are reported every collection until they are
+	// removed. Forget the ones no longer reported so state does not grow
+	// unbounded. Delta timeseries are only reported when they change, their
+	// accumulated state is kept until they are stale.
+	for id, s := range c.streams {
+		missing := c.conversions - s.seen
+		if missing == 0 {
+			continue
+		}
+		if s.input == metricdata.CumulativeTemporality || missing >= c.deltaStaleness {
+			delete(c.streams, id)
+		}
+	}
+
+	return out
+}
+
+func (c *temporalityConverter) convertAggregation(
+	scope instrumentation.Scope,
+	name string,
+	agg metricdata.Aggregation,
+) metricdata.Aggregation {
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		return convertSum(c, scope, name, a)
+	case metricdata.Sum[float64]:
+		return convertSum(c, scope, name, a)
+	case metricdata.Histogram[int64]:
+		return convertHistogram(c, scope, name, a)
+	case metricdata.Histogram[float64]:
+		return convertHistogram(c, scope, name, a)
+	case metricdata.ExponentialHistogram[int64]:
+		return convertExpoHistogram(c, scope, name, a)
+	case metricdata.ExponentialHistogram[float64]:
+		return convertExpoHistogram(c, scope, name, a)
+	}
+	// Gauge and Summary data do not have a temporality.
+	return agg
+}
+
+// state returns the streamState for the timeseries of scope, name, and attrs
+// that has input temporality. If the timeseries was previously seen with a
+// different input temporality, its state is reset.
+func (c *temporalityConverter) state(
+	scope instrumentation.Scope,
+	name string,
+	attrs attribute.Set,
+	input metricdata.Temporality,
+) *streamState {
+	id := streamID{
+		scopeName:      scope.Name,
+		scopeVersion:   scope.Version,
+		scopeSchemaURL: scope.SchemaURL,
+		scopeAttrs:     scope.Attributes.Equivalent(),
+		name:           name,
+		attrs:          attrs.Equivalent(),
+	}
+	s, ok := c.streams[id]
+	if !ok {
+		s = &streamState{input: input}
+		c.streams[id] = s
+	} else if s.input != input {
+		s.input = input
+		s.point = nil
+	}
+	s.seen = c.conversions
+	return s
+}
+
+// needsConversion returns if data with temporality from needs to be
+// converted to temporality to.
+func needsConversion(from, to metricdata.Temporality) bool {
+	switch from {
+	case metricdata.CumulativeTemporality, metricdata.DeltaTemporality:
+	default:
+		// Unknown temporality, nothing can be done.
+		return false
+	}
+	return from != to && (to == metricdata.CumulativeTemporality || to == metricdata.DeltaTemporality)
+}
+
+func convertSum[N int64 | float64](
+	c *temporalityConverter,
+	scope instrumentation.Scope,
+	name string,
+	s metricdata.Sum[N],
+) metricdata.Sum[N] {
+	kind := InstrumentKindUpDownCounter
+	if s.IsMonotonic {
+		kind = InstrumentKindCounter
+	}
+	to := c.selector(kind)
+	if !needsConversion(s.Temporality, to) {
+		return s
+	}
+
+	out := metricdata.Sum[N]{
+		DataPoints:  make([]metricdata.DataPoint[N], len(s.DataPoints)),
+		Temporality: to,
+		IsMonotonic: s.IsMonotonic,
+	}
+	for i, dp := range s.DataPoints {
+		st := c.state(scope, name, dp.Attributes, s.Temporality)
+		if s.Temporality == metricdata.CumulativeTemporality {
+			out.DataPoints[i] = sumToDelta(st, dp, s.IsMonotonic)
+		} else {
+			out.DataPoints[i] = sumToCumulative(st, dp)
+		}
+	}
+	return out
+}
+
+// sumToDelta returns the delta of the cumulative dp from the last cumulative
+// data point stored in st. The state of st is updated to dp.
+func sumToDelta[N int64 | float64](
+	st *streamState,
+	dp metricdata.DataPoint[N],
+	monotonic bool,
+) metricdata.DataPoint[N] {
+	prev, ok := st.point.(metricdata.DataPoint[N])
+
+	last := dp
+	last.Exemplars = nil
+	st.point = last
+
+	if !ok || !dp.StartTime.Equal(prev.StartTime) || (monotonic && dp.Value < prev.Value) {
+		// First or reset timeseries. The cumulative value is the delta since
+		// the start of the timeseries.
+		return dp
+	}
+	return metricdataops.DiffDataPoints(dp, prev)
+}
+
+// sumToCumulative returns the accumulation of the delta dp with the
+// accumulated value stored in st. The state of st is updated to the result.
+func sumToCumulative[N int64 | float64](
+	st *streamState,
+	dp metricdata.DataPoint[N],
+) metricdata.DataPoint[N] {
+	acc, ok := st.point.(metricdata.DataPoint[N])
+	if ok && !dp.StartTime.Before(acc.Time) {
+		dp = metricdataops.AddDataPoints(dp, acc)
+	}
+
+	last := dp
+	last.Exemplars = nil
+	st.point = last
+	return dp
+}
+
+func convertHistogram[N int64 | float64](
+	c *temporalityConverter,
+	scope instrumentation.Scope,
+	name string,
+	h metricdata.Histogram[N],
+) metricdata.Histogram[N] {
+	to := c.selector(InstrumentKindHistogram)
+	if !needsConversion(h.Temporality, to) {
+		return h
+	}
+
+	out := metricdata.Histogram[N]{
+		DataPoints:  make([]metricdata.HistogramDataPoint[N], len(h.DataPoints)),
+		Temporality: to,
+	}
+	for i, dp := range h.DataPoints {
+		st := c.state(scope, name, dp.Attributes, h.Temporality)
+		if h.Temporality == metricdata.CumulativeTemporality {
+			out.DataPoints[i] = histogramToDelta(st, dp)
+		} else {
+			out.DataPoints[i] = histogramToCumulative(st, dp)
+		}
+	}
+	return out
+}
+
+// histogramToDelta returns the delta of the cumulative dp from the last
+// cumulative data point stored in st. The state of st is updated to dp.
+func histogramToDelta[N int64 | float64](
+	st *streamState,
+	dp metricdata.HistogramDataPoint[N],
+) metricdata.HistogramDataPoint[N] {
+	prev, ok := st.point.(metricdata.HistogramDataPoint[N])
+
+	last := dp
+	last.Bounds = slices.Clone(dp.Bounds)
+	last.BucketCounts = slices.Clone(dp.BucketCounts)
+	last.Exemplars = nil
+	st.point = last
+
+	if !ok || !dp.StartTime.Equal(prev.StartTime) {
+		return dp
+	}
+	delta, err := metricdataops.DiffHistogramDataPoints(dp, prev)
+	if err != nil {
+		// A count decreased or the buckets changed, the timeseries was reset.
+		return dp
+	}
+	return delta
+}
+
+// histogramToCumulative returns the accumulation of the delta dp with the
+// accumulated value stored in st. The state of st is updated to the result.
+func histogramToCumulative[N int64 | float64](
+	st *streamState,
+	dp metricdata.HistogramDataPoint[N],
+) metricdata.HistogramDataPoint[N] {
+	var accumulated bool
+	if acc, ok := st.point.(metricdata.HistogramDataPoint[N]); ok && !dp.StartTime.Before(acc.Time) {
+		if sum, err := metricdataops.AddHistogramDataPoints(dp, acc); err == nil {
+			dp, accumulated = sum, true
+		}
+	}
+	if !accumulated {
+		dp.Bounds = slices.Clone(dp.Bounds)
+		dp.BucketCounts = slices.Clone(dp.BucketCounts)
+	}
+
+	last := dp
+	last.Exemplars = nil
+	st.point = last
+	// The returned data point shares its bounds and counts with the state.
+	// Copy them so the stored state is not modified by the exporter.
+	dp.Bounds = slices.Clone(dp.Bounds)
+	dp.BucketCounts = slices.Clone(dp.BucketCounts)
+	return dp
+}
+
+func convertExpoHistogram[N int64 | float64](
+	c *temporalityConverter,
+	scope instrumentation.Scope,
+	name string,
+	h metricdata.ExponentialHistogram[N],
+) metricdata.ExponentialHistogram[N] {
+	to := c.selector(InstrumentKindHistogram)
+	if !needsConversion(h.Temporality, to) {
+		return h
+	}
+
+	out := metricdata.ExponentialHistogram[N]{
+		DataPoints:  make([]metricdata.ExponentialHistogramDataPoint[N], len(h.DataPoints)),
+		Temporality: to,
+	}
+	for i, dp := range h.DataPoints {
+		st := c.state(scope, name, dp.Attributes, h.Temporality)
+		if h.Temporality == metricdata.CumulativeTemporality {
+			out.DataPoints[i] = expoHistogramToDelta(st, dp)
+		} else {
+			out.DataPoints[i] = expoHistogramToCumulative(st, dp)
+		}
+	}
+	return out
+}
+
+// expoHistogramToDelta returns the delta of the cumulative dp from the last
+// cumulative data point stored in st. The state of st is updated to dp.
+func expoHistogramToDelta[N int64 | float64](
+	st *streamState,
+	dp metricdata.ExponentialHistogramDataPoint[N],
+) metricdata.ExponentialHistogramDataPoint[N] {
+	prev, ok := st.point.(metricdata.ExponentialHistogramDataPoint[N])
+
+	last := dp
+	last.PositiveBucket = cloneExpoBucket(dp.PositiveBucket)
+	last.NegativeBucket = cloneExpoBucket(dp.NegativeBucket)
+	last.Exemplars = nil
+	st.point = last
+
+	if !ok || !dp.StartTime.Equal(prev.StartTime) {
+		return dp
+	}
+	delta, err := metricdataops.DiffExponentialHistogramDataPoints(dp, prev)
+	if err != nil {
+		// A count decreased or the zero threshold changed, the timeseries was
+		// reset.
+		return dp
+	}
+	return delta
+}
+
+// expoHistogramToCumulative returns the accumulation of the delta dp with the
+// accumulated value stored in st. The state of st is updated to the result.
+func expoHistogramToCumulative[N int64 | float64](
+	st *streamState,
+	dp metricdata.ExponentialHistogramDataPoint[N],
+) metricdata.ExponentialHistogramDataPoint[N] {
+	var accumulated bool
+	if acc, ok := st.point.(metricdata.ExponentialHistogramDataPoint[N]); ok && !dp.StartTime.Before(acc.Time) {
+		if sum, err := metricdataops.AddExponentialHistogramDataPoints(dp, acc); err == nil {
+			dp, accumulated = sum, true
+		}
+	}
+	if !accumulated {
+		dp.PositiveBucket = cloneExpoBucket(dp.PositiveBucket)
+		dp.NegativeBucket = cloneExpoBucket(dp.NegativeBucket)
+	}
+
+	last := dp
+	last.Exemplars = nil
+	st.point = last
+	dp.PositiveBucket = cloneExpoBucket(dp.PositiveBucket)
+	dp.NegativeBucket = cloneExpoBucket(dp.NegativeBucket)
+	return dp
+}
+
+func cloneExpoBucket(b metricdata.ExponentialBucket) metricdata.ExponentialBucket {
+	return metricdata.ExponentialBucket{Offset: b.Offset, Counts: slices.Clone(b.Counts)}
+}
@@ -0,0 +1,350 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+var (
+	tempScope = instrumentation.Scope{Name: "temporality"}
+	tempAttrs = attribute.NewSet(attribute.String("user", "alice"))
+	tempEpoch = time.Unix(0, 0)
+)
+
+func tempTime(sec int) time.Time { return tempEpoch.Add(time.Duration(sec) * time.Second) }
+
+func sumScope(
+	temp metricdata.Temporality,
+	monotonic bool,
+	dps ...metricdata.DataPoint[int64],
+) []metricdata.ScopeMetrics {
+	return []metricdata.ScopeMetrics{{
+		Scope: tempScope,
+		Metrics: []metricdata.Metrics{{
+			Name: "sum",
+			Data: metricdata.Sum[int64]{
+				DataPoints:  dps,
+				Temporality: temp,
+				IsMonotonic: monotonic,
+			},
+		}},
+	}}
+}
+
+func sumPoint(start, end int, v int64) metricdata.DataPoint[int64] {
+	return metricdata.DataPoint[int64]{
+		Attributes: tempAttrs,
+		StartTime:  tempTime(start),
+		Time:       tempTime(end),
+		Value:      v,
+	}
+}
+
+func TestTemporalityConverterSumToDelta(t *testing.T) {
+	c := newTemporalityConverter(DeltaTemporalitySelector)
+	cumulative := func(monotonic bool, dp metricdata.DataPoint[int64]) []metricdata.ScopeMetrics {
+		return sumScope(metricdata.CumulativeTemporality, monotonic, dp)
+	}
+	delta := func(monotonic bool, dp metricdata.DataPoint[int64]) []metricdata.ScopeMetrics {
+		return sumScope(metricdata.DeltaTemporality, monotonic, dp)
+	}
+
+	steps := []struct {
+		name string
+		in   metricdata.DataPoint[int64]
+		want metricdata.DataPoint[int64]
+	}{
+		{"First", sumPoint(0, 1, 5), sumPoint(0, 1, 5)},
+		{"Increase", sumPoint(0, 2, 8), sumPoint(1, 2, 3)},
+		{"NoChange", sumPoint(0, 3, 8), sumPoint(2, 3, 0)},
+		{"DecreaseReset", sumPoint(0, 4, 2), sumPoint(0, 4, 2)},
+		{"AfterReset", sumPoint(0, 5, 4), sumPoint(4, 5, 2)},
+		{"StartTimeReset", sumPoint(5, 6, 7), sumPoint(5, 6, 7)},
+	}
+	for _, s := range steps {
+		t.Run(s.name, func(t *testing.T) {
+			metricdatatest.AssertEqual(t, delta(true, s.want)[0], c.convert(cumulative(true, s.in))[0])
+		})
+	}
+
+	// UpDownCounters keep a cumulative temporality with the delta selector.
+	in := sumScope(metricdata.CumulativeTemporality, false, sumPoint(0, 1, 5))
+	metricdatatest.AssertEqual(t, in[0], c.convert(in)[0])
+}
+
+func TestTemporalityConverterSumNonMonotonicToDelta(t *testing.T) {
+	c := newTemporalityConverter(func(InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	})
+	c.convert(sumScope(metricdata.CumulativeTemporality, false, sumPoint(0, 1, 5)))
+	got := c.convert(sumScope(metricdata.CumulativeTemporality, false, sumPoint(0, 2, 2)))
+	want := sumScope(metricdata.DeltaTemporality, false, sumPoint(1, 2, -3))
+	metricdatatest.AssertEqual(t, want[0], got[0])
+}
+
+func TestTemporalityConverterSumToCumulative(t *testing.T) {
+	c := newTemporalityConverter(CumulativeTemporalitySelector)
+
+	steps := []struct {
+		name string
+		in   metricdata.DataPoint[int64]
+		want metricdata.DataPoint[int64]
+	}{
+		{"First", sumPoint(0, 1, 5), sumPoint(0, 1, 5)},
+		{"Contiguous", sumPoint(1, 2, 3), sumPoint(0, 2, 8)},
+		{"Gap", sumPoint(4, 5, 2), sumPoint(0, 5, 10)},
+		{"OverlapReset", sumPoint(3, 6, 1), sumPoint(3, 6, 1)},
+		{"AfterReset", sumPoint(6, 7, 1), sumPoint(3, 7, 2)},
+	}
+	for _, s := range steps {
+		t.Run(s.name, func(t *testing.T) {
+			in := sumScope(metricdata.DeltaTemporality, true, s.in)
+			want := sumScope(metricdata.CumulativeTemporality, true, s.want)
+			metricdatatest.AssertEqual(t, want[0], c.convert(in)[0])
+		})
+	}
+}
+
+func TestTemporalityConverterForgetsRemovedCumulativeStreams(t *testing.T) {
+	c := newTemporalityConverter(DeltaTemporalitySelector)
+	c.convert(sumScope(metricdata.CumulativeTemporality, true, sumPoint(0, 1, 5)))
+	require.Len(t, c.streams, 1)
+	c.convert(sumScope(metricdata.CumulativeTemporality, true))
+	assert.Empty(t, c.streams)
+
+	c = newTemporalityConverter(CumulativeTemporalitySelector)
+	c.convert(sumScope(metricdata.DeltaTemporality, true, sumPoint(0, 1, 5)))
+	c.convert(sumScope(metricdata.DeltaTemporality, true))
+	assert.Len(t, c.streams, 1, "delta stream state forgotten")
+}
+
+func TestTemporalityConverterForgetsStaleDeltaStreams(t *testing.T) {
+	c := newTemporalityConverter(CumulativeTemporalitySelector)
+	c.convert(sumScope(metricdata.DeltaTemporality, true, sumPoint(0, 1, 5)))
+	for range dfltDeltaStaleness - 1 {
+		c.convert(sumScope(metricdata.DeltaTemporality, true))
+	}
+	require.Len(t, c.streams, 1, "delta stream forgotten before it is stale")
+
+	// Reporting the stream again keeps its state.
+	got := c.convert(sumScope(metricdata.DeltaTemporality, true, sumPoint(1, 2, 3)))
+	want := sumScope(metricdata.CumulativeTemporality, true, sumPoint(0, 2, 8))
+	metricdatatest.AssertEqual(t, want[0], got[0])
+
+	for range dfltDeltaStaleness {
+		c.convert(sumScope(metricdata.DeltaTemporality, true))
+	}
+	assert.Empty(t, c.streams, "stale delta stream not forgotten")
+
+	// A stream reported after being forgotten restarts its accumulation.
+	got = c.convert(sumScope(metricdata.DeltaTemporality, true, sumPoint(20, 21, 1)))
+	want = sumScope(metricdata.CumulativeTemporality, true, sumPoint(20, 21, 1))
+	metricdatatest.AssertEqual(t, want[0], got[0])
+}
+
+func histScope(temp metricdata.Temporality, dp metricdata.HistogramDataPoint[float64]) []metricdata.ScopeMetrics {
+	return []metricdata.ScopeMetrics{{
+		Scope: tempScope,
+		Metrics: []metricdata.Metrics{{
+			Name: "histogram",
+			Data: metricdata.Histogram[float64]{
+				DataPoints:  []metricdata.HistogramDataPoint[float64]{dp},
+				Temporality: temp,
+			},
+		}},
+	}}
+}
+
+func TestTemporalityConverterHistogram(t *testing.T) {
+	point := func(
+		start, end int,
+		counts []uint64,
+		sum float64,
+		extrema ...float64,
+	) metricdata.HistogramDataPoint[float64] {
+		var n uint64
+		for _, c := range counts {
+			n += c
+		}
+		dp := metricdata.HistogramDataPoint[float64]{
+			Attributes:   tempAttrs,
+			StartTime:    tempTime(start),
+			Time:         tempTime(end),
+			Count:        n,
+			Bounds:       []float64{1, 10},
+			BucketCounts: counts,
+			Sum:          sum,
+		}
+		if len(extrema) == 2 {
+			dp.Min = metricdata.NewExtrema(extrema[0])
+			dp.Max = metricdata.NewExtrema(extrema[1])
+		}
+		return dp
+	}
+
+	t.Run("ToDelta", func(t *testing.T) {
+		c := newTemporalityConverter(DeltaTemporalitySelector)
+		first := point(0, 1, []uint64{1, 2, 0}, 12, 0.5, 6)
+		got := c.convert(histScope(metricdata.CumulativeTemporality, first))
+		metricdatatest.AssertEqual(t, histScope(metricdata.DeltaTemporality, first)[0], got[0])
+
+		second := point(0, 2, []uint64{1, 3, 1}, 30, 0.5, 12)
+		got = c.convert(histScope(metricdata.CumulativeTemporality, second))
+		want := point(1, 2, []uint64{0, 1, 1}, 18)
+		metricdatatest.AssertEqual(t, histScope(metricdata.DeltaTemporality, want)[0], got[0])
+
+		reset := point(0, 3, []uint64{0, 1, 0}, 2, 2, 2)
+		got = c.convert(histScope(metricdata.CumulativeTemporality, reset))
+		metricdatatest.AssertEqual(t, histScope(metricdata.DeltaTemporality, reset)[0], got[0])
+	})
+
+	t.Run("ToCumulative", func(t *testing.T) {
+		c := newTemporalityConverter(CumulativeTemporalitySelector)
+		c.convert(histScope(metricdata.DeltaTemporality, point(0, 1, []uint64{1, 2, 0}, 12, 0.5, 6)))
+		in := histScope(metricdata.DeltaTemporality, point(1, 2, []uint64{0, 1, 1}, 18, 5, 12))
+		got := c.convert(in)
+		want := point(0, 2, []uint64{1, 3, 1}, 30, 0.5, 12)
+		metricdatatest.AssertEqual(t, histScope(metricdata.CumulativeTemporality, want)[0], got[0])
+		inDP := in[0].Metrics[0].Data.(metricdata.Histogram[float64]).DataPoints[0]
+		assert.Equal(t, []uint64{0, 1, 1}, inDP.BucketCounts, "input modified")
+	})
+}
+
+func expoScope(
+	temp metricdata.Temporality,
+	dp metricdata.ExponentialHistogramDataPoint[int64],
+) []metricdata.ScopeMetrics {
+	return []metricdata.ScopeMetrics{{
+		Scope: tempScope,
+		Metrics: []metricdata.Metrics{{
+			Name: "exponential",
+			Data: metricdata.ExponentialHistogram[int64]{
+				DataPoints:  []metricdata.ExponentialHistogramDataPoint[int64]{dp},
+				Temporality: temp,
+			},
+		}},
+	}}
+}
+
+func TestTemporalityConverterExponentialHistogram(t *testing.T) {
+	point := func(start, end int, scale, offset int32, counts ...uint64) metricdata.ExponentialHistogramDataPoint[int64] {
+		var n uint64
+		for _, c := range counts {
+			n += c
+		}
+		return metricdata.ExponentialHistogramDataPoint[int64]{
+			Attributes:     tempAttrs,
+			StartTime:      tempTime(start),
+			Time:           tempTime(end),
+			Count:          n,
+			Sum:            int64(n),
+			Scale:          scale,
+			PositiveBucket: metricdata.ExponentialBucket{Offset: offset, Counts: counts},
+		}
+	}
+
+	t.Run("ToDelta", func(t *testing.T) {
+		c := newTemporalityConverter(DeltaTemporalitySelector)
+		c.convert(expoScope(metricdata.CumulativeTemporality, point(0, 1, 1, 0, 1, 1, 1, 1)))
+		// Downscaled by the aggregator: buckets {0,1} and {2,3} are merged.
+		got := c.convert(expoScope(metricdata.CumulativeTemporality, point(0, 2, 0, 0, 3, 2, 1)))
+		want := point(1, 2, 0, 0, 1, 0, 1)
+		metricdatatest.AssertEqual(t, expoScope(metricdata.DeltaTemporality, want)[0], got[0])
+	})
+
+	t.Run("ToCumulative", func(t *testing.T) {
+		c := newTemporalityConverter(CumulativeTemporalitySelector)
+		c.convert(expoScope(metricdata.DeltaTemporality, point(0, 1, 1, 2, 1, 1)))
+		got := c.convert(expoScope(metricdata.DeltaTemporality, point(1, 2, 0, -1, 1, 0, 2)))
+		want := point(0, 2, 0, -1, 1, 0, 4)
+		metricdatatest.AssertEqual(t, expoScope(metricdata.CumulativeTemporality, want)[0], got[0])
+	})
+}
+
+func TestTemporalityConverterPassThrough(t *testing.T) {
+	c := newTemporalityConverter(DeltaTemporalitySelector)
+	in := []metricdata.ScopeMetrics{{
+		Scope: tempScope,
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{sumPoint(0, 1, 1)}},
+			},
+			{
+				Name: "summary",
+				Data: metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{{Attributes: tempAttrs}}},
+			},
+		},
+	}}
+	metricdatatest.AssertEqual(t, in[0], c.convert(in)[0])
+	assert.Empty(t, c.streams)
+}
+
+func TestTemporalityExporter(t *testing.T) {
+	var got *metricdata.ResourceMetrics
+	exp := &fnExporter{
+		temporalityFunc: DeltaTemporalitySelector,
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			got = rm
+			return nil
+		},
+	}
+	e := NewTemporalityExporter(exp, nil)
+	assert.Equal(t, metricdata.CumulativeTemporality, e.Temporality(InstrumentKindCounter))
+
+	rdr := NewManualReader(WithTemporalitySelector(e.Temporality))
+	mp := NewMeterProvider(WithReader(rdr))
+	ctr, err := mp.Meter("test").Int64Counter("counter")
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	for _, want := range []int64{3, 4} {
+		ctr.Add(ctx, want)
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, rdr.Collect(ctx, &rm))
+		require.NoError(t, e.Export(ctx, &rm))
+		require.NotNil(t, got)
+
+		require.Len(t, got.ScopeMetrics, 1)
+		require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+		sum, ok := got.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		assert.Equal(t, metricdata.DeltaTemporality, sum.Temporality)
+		require.Len(t, sum.DataPoints, 1)
+		assert.Equal(t, want, sum.DataPoints[0].Value)
+
+		in := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		assert.Equal(t, metricdata.CumulativeTemporality, in.Temporality, "input modified")
+	}
+}
+
+func TestTemporalityProducer(t *testing.T) {
+	var next int64
+	p := NewTemporalityProducer(testExternalProducer{
+		produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+			next++
+			return sumScope(metricdata.DeltaTemporality, true, sumPoint(int(next-1), int(next), 2)), nil
+		},
+	}, nil)
+
+	for i := range 3 {
+		got, err := p.Produce(t.Context())
+		require.NoError(t, err)
+		want := sumScope(metricdata.CumulativeTemporality, true, sumPoint(0, i+1, int64(2*(i+1))))
+		require.Len(t, got, 1)
+		metricdatatest.AssertEqual(t, want[0], got[0])
+	}
+}
@@ -7,12 +7,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace/internal/env"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
 	"go.opentelemetry.io/otel/trace"
@@ -55,6 +58,15 @@ type BatchSpanProcessorOptions struct {
 	// The default value of MaxExportBatchSize is 512.
 	MaxExportBatchSize int
 
+	// MaxExportBatchBytes is the maximum estimated size, in bytes, of the
+	// OTLP protobuf encoding of the spans of a single batch, including their
+	// resource and instrumentation scope. A batch is exported before it
+	// exceeds this size. A span larger than this size is exported alone.
+	// The size of batches is not limited if MaxExportBatchBytes is less than
+	// or equal to zero.
+	// The default value of MaxExportBatchBytes is 0.
+	MaxExportBatchBytes int
+
 	// BlockOnQueueFull blocks onEnd() and onStart() method if the queue is full
 	// AND if BlockOnQueueFull is set to true.
 	// Blocking option should be used carefully as it can severely affect the performance of an
@@ -75,11 +87,18 @@ type batchSpanProcessor struct {
 
 	batch      []ReadOnlySpan
 	batchMutex sync.Mutex
-	timer      *time.Timer
-	stopWait   sync.WaitGroup
-	stopOnce   sync.Once
-	stopCh     chan struct{}
-	stopped    atomic.Bool
+	// batchBytes is the estimated encoded size of batch, with the resources
+	// and instrumentation scopes of its spans in batchResources and
+	// batchScopes. They are only tracked if MaxExportBatchBytes is positive.
+	batchBytes     int
+	batchResources []*resource.Resource
+	batchScopes    []instrumentation.Scope
+
+	timer    *time.Timer
+	stopWait sync.WaitGroup
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	stopped  atomic.Bool
 }
 
 var _ SpanProcessor = (*batchSpanProcessor)(nil)
@@ -255,6 +274,24 @@ func WithMaxExportBatchSize(size int) BatchSpanProcessorOption {
 	}
 }
 
+// WithMaxExportBatchBytes returns a BatchSpanProcessorOption that configures
+// the maximum size, in bytes, of the batches exported by a
+// BatchSpanProcessor. A batch is exported before adding a span that would
+// make it exceed this size, and a span that alone exceeds it is exported in
+// its own batch.
+//
+// The size of a batch is an upper bound estimate of its OTLP protobuf
+// encoding, so the maximum request size of the exporter (e.g. set with the
+// WithMaxRequestSize option of the OTLP exporters) is a safe value.
+//
+// By default, or if size is less than or equal to zero, the size of batches
+// is not limited.
+func WithMaxExportBatchBytes(size int) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxExportBatchBytes = size
+	}
+}
+
 // WithBatchTimeout returns a BatchSpanProcessorOption that configures the
 // maximum delay allowed for a BatchSpanProcessor before it will export any
 // held span (whether the queue is full or not).
@@ -308,6 +345,11 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 		// to be exported, since it is specific to the protocol and backend being sent to.
 		clear(bsp.batch) // Erase elements to let GC collect objects
 		bsp.batch = bsp.batch[:0]
+		bsp.batchBytes = 0
+		clear(bsp.batchResources)
+		bsp.batchResources = bsp.batchResources[:0]
+		clear(bsp.batchScopes)
+		bsp.batchScopes = bsp.batchScopes[:0]
 
 		if err != nil {
 			return err
@@ -337,11 +379,7 @@ func (bsp *batchSpanProcessor) processQueue() {
 				close(ffs.flushed)
 				continue
 			}
-			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
-			bsp.batchMutex.Unlock()
-			if shouldExport {
+			if shouldExport := bsp.addToBatch(ctx, sd); shouldExport {
 				if !bsp.timer.Stop() {
 					// Handle both GODEBUG=asynctimerchan=[0|1] properly.
 					select {
@@ -370,12 +408,7 @@ func (bsp *batchSpanProcessor) drainQueue() {
 				continue
 			}
 
-			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
-			bsp.batchMutex.Unlock()
-
-			if shouldExport {
+			if shouldExport := bsp.addToBatch(ctx, sd); shouldExport {
 				if err := bsp.exportSpans(ctx); err != nil {
 					otel.Handle(err)
 				}
@@ -390,6 +423,53 @@ func (bsp *batchSpanProcessor) drainQueue() {
 	}
 }
 
+// addToBatch adds sd to the batch and reports whether the batch is full and
+// should be exported. If adding sd would make the batch exceed
+// MaxExportBatchBytes, the batch is exported first.
+func (bsp *batchSpanProcessor) addToBatch(ctx context.Context, sd ReadOnlySpan) bool {
+	limit := bsp.o.MaxExportBatchBytes
+
+	bsp.batchMutex.Lock()
+	var size int
+	if limit > 0 {
+		size = spanSize(sd)
+		if len(bsp.batch) > 0 && bsp.batchBytes+size+bsp.sharedSize(sd) > limit {
+			bsp.batchMutex.Unlock()
+			if err := bsp.exportSpans(ctx); err != nil {
+				otel.Handle(err)
+			}
+			bsp.batchMutex.Lock()
+		}
+	}
+	defer bsp.batchMutex.Unlock()
+
+	if limit > 0 {
+		bsp.batchBytes += size + bsp.sharedSize(sd)
+		if res := sd.Resource(); !slices.Contains(bsp.batchResources, res) {
+			bsp.batchResources = append(bsp.batchResources, res)
+		}
+		if scope := sd.InstrumentationScope(); !slices.Contains(bsp.batchScopes, scope) {
+			bsp.batchScopes = append(bsp.batchScopes, scope)
+		}
+	}
+	bsp.batch = append(bsp.batch, sd)
+	return len(bsp.batch) >= bsp.o.MaxExportBatchSize || (limit > 0 && bsp.batchBytes >= limit)
+}
+
+// sharedSize returns the estimated size the resource and instrumentation
+// scope of sd add to the encoding of the batch, which is zero for those
+// already in the batch. The batchMutex must be held.
+func (bsp *batchSpanProcessor) sharedSize(sd ReadOnlySpan) int {
+	var n int
+	if res := sd.Resource(); !slices.Contains(bsp.batchResources, res) {
+		n += resourceSize(res)
+	}
+	if scope := sd.InstrumentationScope(); !slices.Contains(bsp.batchScopes, scope) {
+		n += scopeSize(scope)
+	}
+	return n
+}
+
 func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 	ctx := context.TODO()
 	if bsp.o.BlockOnQueueFull {
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -24,6 +25,7 @@ import (
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace/internal/env"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
@@ -378,6 +380,35 @@ func getSpanContext() trace.SpanContext {
 	})
 }
 
+func TestBatchSpanProcessorMaxExportBatchBytes(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("service.name", "svc"))
+	scope := instrumentation.Scope{Name: "scope"}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceFlags: trace.FlagsSampled})
+	newSpan := func(n int) ReadOnlySpan {
+		return &snapshot{
+			name:                 "span",
+			spanContext:          sc,
+			attributes:           []attribute.KeyValue{attribute.String("data", strings.Repeat("x", n))},
+			resource:             res,
+			instrumentationScope: scope,
+		}
+	}
+	// Room for two spans of 1000 bytes per batch.
+	limit := resourceSize(res) + scopeSize(scope) + 2*spanSize(newSpan(1000)) + 10
+
+	var te testBatchExporter
+	bsp := NewBatchSpanProcessor(&te, WithBatchTimeout(time.Hour), WithMaxExportBatchBytes(limit))
+	for _, n := range []int{1000, 1000, 1000, 1000, 1000, 10, 5000, 10} {
+		bsp.OnEnd(newSpan(n))
+	}
+	require.NoError(t, bsp.Shutdown(t.Context()))
+
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	assert.Equal(t, []int{2, 2, 2, 1, 1}, te.sizes)
+	assert.Len(t, te.spans[6].Attributes()[0].Value.AsString(), 5000, "span larger than limit exported alone")
+}
+
 func TestBatchSpanProcessorShutdown(t *testing.T) {
 	var bp testBatchExporter
 	bsp := NewBatchSpanProcessor(&bp)
@@ -27,9 +27,24 @@ type IDGenerator interface {
 	// must never be done outside of a new major release.
 }
 
+// RandomTraceIDGenerator is an IDGenerator that reports whether the trace IDs
+// it generates are random.
+//
+// If the IDGenerator of a TracerProvider implements RandomTraceIDGenerator
+// and RandomTraceIDs returns true, the TracerProvider sets the
+// [trace.FlagsRandom] flag for the root spans it starts.
+type RandomTraceIDGenerator interface {
+	IDGenerator
+
+	// RandomTraceIDs reports whether at least the rightmost 7 bytes of the
+	// trace IDs returned by NewIDs are random, as required by the
+	// [trace.FlagsRandom] flag. The result must not change over time.
+	RandomTraceIDs() bool
+}
+
 type randomIDGenerator struct{}
 
-var _ IDGenerator = &randomIDGenerator{}
+var _ RandomTraceIDGenerator = &randomIDGenerator{}
 
 // NewSpanID returns a non-zero span ID from a randomly-chosen sequence.
 func (*randomIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
@@ -64,6 +79,15 @@ func (*randomIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID)
 	return tid, sid
 }
 
+// RandomTraceIDs returns true. The trace IDs are generated randomly.
+func (*randomIDGenerator) RandomTraceIDs() bool { return true }
+
+// randomTraceIDs reports whether g guarantees random trace IDs.
+func randomTraceIDs(g IDGenerator) bool {
+	r, ok := g.(RandomTraceIDGenerator)
+	return ok && r.RandomTraceIDs()
+}
+
 func defaultIDGenerator() IDGenerator {
 	return &randomIDGenerator{}
 }
@@ -38,3 +38,10 @@ func TestNewSpanIDWithInvalidTraceID(t *testing.T) {
 	spanID := gen.NewSpanID(t.Context(), trace.TraceID{})
 	assert.Truef(t, spanID.IsValid(), "span id: %s", spanID.String())
 }
+
+func TestRandomTraceIDs(t *testing.T) {
+	assert.True(t, randomTraceIDs(defaultIDGenerator()))
+	assert.False(t, randomTraceIDs(&testIDGenerator{}))
+	assert.False(t, randomTraceIDs(randomIDs{IDGenerator: &testIDGenerator{}, random: false}))
+	assert.True(t, randomTraceIDs(randomIDs{IDGenerator: &testIDGenerator{}, random: true}))
+}
@@ -82,6 +82,7 @@ type TracerProvider struct {
 	// immutable after creation of the TracerProvider.
 	sampler                Sampler
 	idGenerator            IDGenerator
+	randomTraceIDs         bool
 	spanLimits             SpanLimits
 	resource               *resource.Resource
 	panicRecordingDisabled bool
@@ -122,6 +123,7 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 		namedTracer:            make(map[instrumentation.Scope]*tracer),
 		sampler:                o.sampler,
 		idGenerator:            o.idGenerator,
+		randomTraceIDs:         randomTraceIDs(o.idGenerator),
 		spanLimits:             o.spanLimits,
 		resource:               o.resource,
 		panicRecordingDisabled: o.panicRecordingDisabled,
@@ -399,8 +401,11 @@ func WithResource(r *resource.Resource) TracerProviderOption {
 // is used by the Tracers the TracerProvider creates to generate new Span and
 // Trace IDs.
 //
+// If g implements [RandomTraceIDGenerator] and guarantees random trace IDs,
+// the [trace.FlagsRandom] flag is set for root spans.
+//
 // If this option is not used, the TracerProvider will use a random number
-// IDGenerator by default.
+// IDGenerator by default. It guarantees random trace IDs.
 func WithIDGenerator(g IDGenerator) TracerProviderOption {
 	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
 		if g != nil {
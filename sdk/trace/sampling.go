@@ -37,6 +37,16 @@ type SamplingParameters struct {
 	Kind          trace.SpanKind
 	Attributes    []attribute.KeyValue
 	Links         []trace.Link
+
+	// RandomTraceID reports whether TraceID is known to be random, as
+	// indicated by the [trace.FlagsRandom] flag. It is true if the parent
+	// span context has the flag set, or if the span starts a new trace and
+	// the IDGenerator guarantees random trace IDs (see
+	// [RandomTraceIDGenerator]).
+	//
+	// Consistent probability samplers can rely on the randomness of TraceID
+	// if it is true.
+	RandomTraceID bool
 }
 
 // SamplingDecision indicates whether a span is dropped, recorded and/or sampled.
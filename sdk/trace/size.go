@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/protosize"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	// traceIDSize is the size of a trace ID field.
+	traceIDSize = protosize.TagSize + 1 + 16
+	// spanIDSize is the size of a span ID field.
+	spanIDSize = protosize.TagSize + 1 + 8
+)
+
+// spanSize returns an estimate of the size of the encoding of s as an OTLP
+// Span message within a ScopeSpans message.
+func spanSize(s ReadOnlySpan) int {
+	n := traceIDSize + spanIDSize + spanIDSize + // Trace, span, and parent span IDs.
+		protosize.Len(len(s.SpanContext().TraceState().String())) +
+		protosize.Fixed32Size + // Flags.
+		protosize.Len(len(s.Name())) +
+		protosize.VarintFieldSize + // Kind.
+		2*protosize.Fixed64Size + // Start and end times.
+		protosize.Attributes(s.Attributes()) +
+		3*protosize.VarintFieldSize // Dropped attributes, events, and links counts.
+	for _, e := range s.Events() {
+		n += protosize.Len(protosize.Fixed64Size + protosize.Len(len(e.Name)) +
+			protosize.Attributes(e.Attributes) + protosize.VarintFieldSize)
+	}
+	for _, l := range s.Links() {
+		n += protosize.Len(traceIDSize + spanIDSize +
+			protosize.Len(len(l.SpanContext.TraceState().String())) +
+			protosize.Attributes(l.Attributes) +
+			protosize.VarintFieldSize + // Dropped attributes count.
+			protosize.Fixed32Size) // Flags.
+	}
+	n += protosize.Len(protosize.Len(len(s.Status().Description)) + protosize.VarintFieldSize)
+	return protosize.Len(n)
+}
+
+// resourceSize returns an estimate of the size of the encoding of res as an
+// OTLP ResourceSpans message without its ScopeSpans.
+func resourceSize(res *resource.Resource) int {
+	n := protosize.VarintFieldSize + protosize.Attributes(res.Attributes())
+	return protosize.Len(protosize.Len(n)) + protosize.Len(len(res.SchemaURL()))
+}
+
+// scopeSize returns an estimate of the size of the encoding of s as an OTLP
+// ScopeSpans message without its Spans.
+func scopeSize(s instrumentation.Scope) int {
+	n := protosize.Len(len(s.Name)) + protosize.Len(len(s.Version)) + protosize.VarintFieldSize +
+		protosize.Attributes(s.Attributes.ToSlice())
+	return protosize.Len(protosize.Len(n)) + protosize.Len(len(s.SchemaURL))
+}
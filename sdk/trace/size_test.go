@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestSpanSize(t *testing.T) {
+	s := &snapshot{name: "span"}
+	base := spanSize(s)
+	assert.Positive(t, base)
+
+	s.attributes = []attribute.KeyValue{attribute.String("key", strings.Repeat("v", 100))}
+	withAttrs := spanSize(s)
+	assert.GreaterOrEqual(t, withAttrs, base+len("key")+100)
+
+	s.events = []Event{{Name: strings.Repeat("e", 100)}}
+	withEvents := spanSize(s)
+	assert.GreaterOrEqual(t, withEvents, withAttrs+100)
+
+	s.links = []Link{{Attributes: []attribute.KeyValue{attribute.String("link", strings.Repeat("l", 100))}}}
+	withLinks := spanSize(s)
+	assert.GreaterOrEqual(t, withLinks, withEvents+len("link")+100)
+
+	s.status = Status{Description: strings.Repeat("d", 100)}
+	assert.GreaterOrEqual(t, spanSize(s), withLinks+100)
+}
+
+func TestResourceScopeSize(t *testing.T) {
+	res := resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc"))
+	assert.GreaterOrEqual(t, resourceSize(res), len("https://schema")+len("service.name")+len("svc"))
+	assert.Positive(t, resourceSize(nil))
+
+	scope := instrumentation.Scope{
+		Name:       "name",
+		Version:    "v1",
+		SchemaURL:  "https://schema",
+		Attributes: attribute.NewSet(attribute.String("k", "v")),
+	}
+	assert.GreaterOrEqual(t, scopeSize(scope), len("name")+len("v1")+len("https://schema")+len("kv"))
+}
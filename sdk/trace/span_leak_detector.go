@@ -0,0 +1,340 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultLeakTimeout is the default duration after which a span that has not
+// ended is reported by a SpanLeakDetector.
+const DefaultLeakTimeout = 5 * time.Minute
+
+// DefaultMaxTrackedSpans is the default maximum number of active spans
+// tracked by a SpanLeakDetector.
+const DefaultMaxTrackedSpans = 10000
+
+// sdkPackage is the prefix of the function names of this package.
+const sdkPackage = "go.opentelemetry.io/otel/sdk/trace."
+
+// ActiveSpan is a span that has been started but not yet ended.
+type ActiveSpan struct {
+	// Span is the active span. It reflects the current state of the span.
+	Span ReadOnlySpan
+	// Stack is the stack trace of the goroutine that started the span. Each
+	// function is followed by its file and line on an indented line.
+	Stack string
+}
+
+// SpanLeakDetectorOption configures a SpanLeakDetector.
+type SpanLeakDetectorOption func(cfg *spanLeakDetectorConfig)
+
+type spanLeakDetectorConfig struct {
+	timeout   time.Duration
+	interval  time.Duration
+	handler   func(ActiveSpan)
+	maxSpans  int
+	retention time.Duration
+}
+
+// WithLeakTimeout sets the duration after which a span that has not ended is
+// reported as leaked. Non-positive durations are ignored.
+//
+// By default, [DefaultLeakTimeout] is used.
+func WithLeakTimeout(d time.Duration) SpanLeakDetectorOption {
+	return func(cfg *spanLeakDetectorConfig) {
+		if d > 0 {
+			cfg.timeout = d
+		}
+	}
+}
+
+// WithLeakCheckInterval sets the interval at which active spans are checked
+// for leaks. Non-positive durations are ignored.
+//
+// By default, half of the leak timeout is used.
+func WithLeakCheckInterval(d time.Duration) SpanLeakDetectorOption {
+	return func(cfg *spanLeakDetectorConfig) {
+		if d > 0 {
+			cfg.interval = d
+		}
+	}
+}
+
+// WithLeakHandler sets the function called with each leaked span. It is
+// called once per span, from the goroutine checking for leaks.
+//
+// By default, leaked spans are reported to the global error handler.
+func WithLeakHandler(f func(ActiveSpan)) SpanLeakDetectorOption {
+	return func(cfg *spanLeakDetectorConfig) {
+		if f != nil {
+			cfg.handler = f
+		}
+	}
+}
+
+// WithMaxTrackedSpans sets the maximum number of active spans tracked. Spans
+// started while this many spans are tracked are not tracked, and are counted
+// by [SpanLeakDetector.UntrackedSpans]. Non-positive values are ignored.
+//
+// By default, [DefaultMaxTrackedSpans] is used.
+func WithMaxTrackedSpans(n int) SpanLeakDetectorOption {
+	return func(cfg *spanLeakDetectorConfig) {
+		if n > 0 {
+			cfg.maxSpans = n
+		}
+	}
+}
+
+// WithLeakRetention sets the duration a leaked span is still tracked after it
+// is reported. Once it has elapsed, the span is no longer tracked and no
+// longer returned by [SpanLeakDetector.ActiveSpans], releasing the memory it
+// holds. Non-positive durations are ignored.
+//
+// By default, leaked spans are tracked until they end.
+func WithLeakRetention(d time.Duration) SpanLeakDetectorOption {
+	return func(cfg *spanLeakDetectorConfig) {
+		if d > 0 {
+			cfg.retention = d
+		}
+	}
+}
+
+// SpanLeakDetector is a SpanProcessor that tracks active spans, and reports
+// spans that are not ended after a timeout. A span that is never ended is a
+// leak: it is never exported, and the resources it holds are never released.
+//
+// Tracking spans has a cost for every span started, including the capture of
+// a stack trace. Use SpanLeakDetector for debugging only. The number of spans
+// tracked is limited, see [WithMaxTrackedSpans].
+type SpanLeakDetector struct {
+	cfg spanLeakDetectorConfig
+
+	mu        sync.Mutex
+	active    map[spanKey]*activeSpan
+	untracked int64
+	shutdown  bool
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+// spanKey identifies a span. The span passed to OnEnd is a snapshot of the
+// one passed to OnStart, so they are matched by their IDs.
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func keyOf(s ReadOnlySpan) spanKey {
+	sc := s.SpanContext()
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+type activeSpan struct {
+	span     ReadOnlySpan
+	pcs      []uintptr
+	reported bool
+	// reportedAt is the time the span was reported as leaked.
+	reportedAt time.Time
+}
+
+var _ SpanProcessor = (*SpanLeakDetector)(nil)
+
+// NewSpanLeakDetector returns a new SpanLeakDetector configured with opts.
+// Register it with a TracerProvider using [WithSpanProcessor].
+func NewSpanLeakDetector(opts ...SpanLeakDetectorOption) *SpanLeakDetector {
+	cfg := spanLeakDetectorConfig{timeout: DefaultLeakTimeout, maxSpans: DefaultMaxTrackedSpans}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.interval <= 0 {
+		cfg.interval = cfg.timeout / 2
+	}
+	if cfg.handler == nil {
+		cfg.handler = func(s ActiveSpan) {
+			otel.Handle(leakError{span: s, timeout: cfg.timeout})
+		}
+	}
+
+	d := &SpanLeakDetector{
+		cfg:    cfg,
+		active: make(map[spanKey]*activeSpan),
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go d.run()
+	return d
+}
+
+// OnStart tracks s as active, and captures the stack trace of the caller. If
+// the maximum number of spans are already tracked, s is counted as untracked
+// instead.
+func (d *SpanLeakDetector) OnStart(_ context.Context, s ReadWriteSpan) {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(2, pcs)]
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.shutdown {
+		return
+	}
+	if len(d.active) >= d.cfg.maxSpans {
+		d.untracked++
+		return
+	}
+	d.active[keyOf(s)] = &activeSpan{span: s, pcs: pcs}
+}
+
+// OnEnd stops tracking s.
+func (d *SpanLeakDetector) OnEnd(s ReadOnlySpan) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.active, keyOf(s))
+}
+
+// ActiveSpans returns a snapshot of the spans that have been started but not
+// ended, ordered by their start time.
+func (d *SpanLeakDetector) ActiveSpans() []ActiveSpan {
+	d.mu.Lock()
+	out := make([]ActiveSpan, 0, len(d.active))
+	for _, a := range d.active {
+		out = append(out, ActiveSpan{Span: a.span, Stack: formatStack(a.pcs)})
+	}
+	d.mu.Unlock()
+
+	slices.SortFunc(out, func(a, b ActiveSpan) int {
+		return a.Span.StartTime().Compare(b.Span.StartTime())
+	})
+	return out
+}
+
+// UntrackedSpans returns the number of spans that were not tracked because
+// the maximum number of tracked spans was reached.
+func (d *SpanLeakDetector) UntrackedSpans() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.untracked
+}
+
+// Shutdown stops checking for leaks and stops tracking spans.
+func (d *SpanLeakDetector) Shutdown(ctx context.Context) error {
+	d.stopOnce.Do(func() {
+		d.mu.Lock()
+		d.shutdown = true
+		clear(d.active)
+		d.mu.Unlock()
+		close(d.stopCh)
+	})
+
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ForceFlush does nothing. The SpanLeakDetector does not export spans.
+func (*SpanLeakDetector) ForceFlush(context.Context) error {
+	return nil
+}
+
+func (d *SpanLeakDetector) run() {
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.cfg.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.stopCh:
+			return
+		case now := <-ticker.C:
+			for _, s := range d.leaked(now) {
+				d.cfg.handler(s)
+			}
+		}
+	}
+}
+
+// leaked returns the active spans started more than the leak timeout before
+// now that have not been reported yet, and marks them reported. Spans reported
+// more than the leak retention before now are no longer tracked.
+func (d *SpanLeakDetector) leaked(now time.Time) []ActiveSpan {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var out []ActiveSpan
+	for k, a := range d.active {
+		if a.reported {
+			if d.cfg.retention > 0 && now.Sub(a.reportedAt) >= d.cfg.retention {
+				delete(d.active, k)
+			}
+			continue
+		}
+		if now.Sub(a.span.StartTime()) < d.cfg.timeout {
+			continue
+		}
+		a.reported, a.reportedAt = true, now
+		out = append(out, ActiveSpan{Span: a.span, Stack: formatStack(a.pcs)})
+	}
+	slices.SortFunc(out, func(a, b ActiveSpan) int {
+		return a.Span.StartTime().Compare(b.Span.StartTime())
+	})
+	return out
+}
+
+// formatStack returns the stack trace of pcs without the leading frames of
+// this package that start the span.
+func formatStack(pcs []uintptr) string {
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	leading := true
+	for {
+		f, more := frames.Next()
+		if leading && strings.HasPrefix(f.Function, sdkPackage) && !strings.HasSuffix(f.File, "_test.go") {
+			if !more {
+				break
+			}
+			continue
+		}
+		leading = false
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+type leakError struct {
+	span    ActiveSpan
+	timeout time.Duration
+}
+
+func (e leakError) Error() string {
+	s := e.span.Span
+	var b strings.Builder
+	fmt.Fprintf(&b, "span %q not ended after %s", s.Name(), e.timeout)
+	if attrs := s.Attributes(); len(attrs) > 0 {
+		parts := make([]string, len(attrs))
+		for i, kv := range attrs {
+			parts[i] = string(kv.Key) + "=" + kv.Value.String()
+		}
+		fmt.Fprintf(&b, " {%s}", strings.Join(parts, ","))
+	}
+	b.WriteString(", started at:\n")
+	b.WriteString(e.span.Stack)
+	return b.String()
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewSpanLeakDetectorConfig(t *testing.T) {
+	newDetector := func(opts ...SpanLeakDetectorOption) *SpanLeakDetector {
+		d := NewSpanLeakDetector(opts...)
+		t.Cleanup(func() { require.NoError(t, d.Shutdown(context.Background())) })
+		return d
+	}
+
+	d := newDetector()
+	assert.Equal(t, DefaultLeakTimeout, d.cfg.timeout)
+	assert.Equal(t, DefaultLeakTimeout/2, d.cfg.interval)
+	assert.NotNil(t, d.cfg.handler)
+
+	d = newDetector(WithLeakTimeout(-1), WithLeakCheckInterval(0), WithLeakHandler(nil))
+	assert.Equal(t, DefaultLeakTimeout, d.cfg.timeout)
+	assert.Equal(t, DefaultLeakTimeout/2, d.cfg.interval)
+	assert.NotNil(t, d.cfg.handler)
+
+	assert.Equal(t, DefaultMaxTrackedSpans, d.cfg.maxSpans)
+	assert.Equal(t, time.Duration(0), d.cfg.retention)
+
+	d = newDetector(WithMaxTrackedSpans(0), WithLeakRetention(-1))
+	assert.Equal(t, DefaultMaxTrackedSpans, d.cfg.maxSpans)
+	assert.Equal(t, time.Duration(0), d.cfg.retention)
+
+	d = newDetector(
+		WithLeakTimeout(time.Hour),
+		WithLeakCheckInterval(time.Minute),
+		WithMaxTrackedSpans(10),
+		WithLeakRetention(time.Second),
+	)
+	assert.Equal(t, time.Hour, d.cfg.timeout)
+	assert.Equal(t, time.Minute, d.cfg.interval)
+	assert.Equal(t, 10, d.cfg.maxSpans)
+	assert.Equal(t, time.Second, d.cfg.retention)
+}
+
+func TestSpanLeakDetectorActiveSpans(t *testing.T) {
+	d := NewSpanLeakDetector()
+	tp := NewTracerProvider(WithSpanProcessor(d))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	tracer := tp.Tracer(t.Name())
+	ctx, parent := tracer.Start(t.Context(), "parent")
+	_, child := tracer.Start(ctx, "child")
+
+	active := d.ActiveSpans()
+	require.Len(t, active, 2)
+	assert.Equal(t, "parent", active[0].Span.Name())
+	assert.Equal(t, "child", active[1].Span.Name())
+	assert.True(
+		t,
+		strings.HasPrefix(active[0].Stack, "go.opentelemetry.io/otel/sdk/trace.TestSpanLeakDetectorActiveSpans\n"),
+		"stack starts at the caller:\n%s",
+		active[0].Stack,
+	)
+	assert.Contains(t, active[0].Stack, "span_leak_detector_test.go:")
+
+	child.End()
+	active = d.ActiveSpans()
+	require.Len(t, active, 1)
+	assert.Equal(t, "parent", active[0].Span.Name())
+
+	parent.End()
+	assert.Empty(t, d.ActiveSpans())
+}
+
+func TestSpanLeakDetectorReportsLeaks(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		leaked []ActiveSpan
+	)
+	d := NewSpanLeakDetector(
+		WithLeakTimeout(10*time.Millisecond),
+		WithLeakCheckInterval(time.Millisecond),
+		WithLeakHandler(func(s ActiveSpan) {
+			mu.Lock()
+			defer mu.Unlock()
+			leaked = append(leaked, s)
+		}),
+	)
+	tp := NewTracerProvider(WithSpanProcessor(d))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	tracer := tp.Tracer(t.Name())
+	_, ended := tracer.Start(t.Context(), "ended")
+	ended.End()
+	_, leak := tracer.Start(t.Context(), "leak")
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(leaked) > 0
+	}, time.Second, time.Millisecond)
+
+	// Wait for more checks to ensure the leak is only reported once.
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	require.Len(t, leaked, 1)
+	assert.Equal(t, "leak", leaked[0].Span.Name())
+	assert.Contains(t, leaked[0].Stack, "TestSpanLeakDetectorReportsLeaks")
+	mu.Unlock()
+
+	leak.End()
+	assert.Empty(t, d.ActiveSpans())
+}
+
+func TestSpanLeakDetectorMaxTrackedSpans(t *testing.T) {
+	d := NewSpanLeakDetector(WithMaxTrackedSpans(2))
+	tp := NewTracerProvider(WithSpanProcessor(d))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	tracer := tp.Tracer(t.Name())
+	spans := make([]trace.Span, 4)
+	for i := range spans {
+		_, spans[i] = tracer.Start(t.Context(), "span")
+	}
+	assert.Len(t, d.ActiveSpans(), 2)
+	assert.Equal(t, int64(2), d.UntrackedSpans())
+
+	for _, s := range spans {
+		s.End()
+	}
+	assert.Empty(t, d.ActiveSpans())
+
+	_, span := tracer.Start(t.Context(), "span")
+	defer span.End()
+	assert.Len(t, d.ActiveSpans(), 1, "tracked after spans ended")
+	assert.Equal(t, int64(2), d.UntrackedSpans())
+}
+
+func TestSpanLeakDetectorRetention(t *testing.T) {
+	d := NewSpanLeakDetector(
+		WithLeakTimeout(time.Minute),
+		WithLeakCheckInterval(time.Hour),
+		WithLeakRetention(time.Minute),
+	)
+	tp := NewTracerProvider(WithSpanProcessor(d))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "leak")
+	defer span.End()
+	start := span.(ReadOnlySpan).StartTime()
+
+	assert.Len(t, d.leaked(start.Add(time.Minute)), 1)
+	assert.Len(t, d.ActiveSpans(), 1, "reported span retained")
+	assert.Empty(t, d.leaked(start.Add(time.Minute+time.Second)))
+	assert.Len(t, d.ActiveSpans(), 1, "reported span retained")
+
+	assert.Empty(t, d.leaked(start.Add(2*time.Minute)))
+	assert.Empty(t, d.ActiveSpans(), "reported span not retained")
+}
+
+func TestSpanLeakDetectorShutdown(t *testing.T) {
+	d := NewSpanLeakDetector()
+	tp := NewTracerProvider(WithSpanProcessor(d))
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	require.Len(t, d.ActiveSpans(), 1)
+
+	require.NoError(t, d.Shutdown(t.Context()))
+	assert.Empty(t, d.ActiveSpans())
+	require.NoError(t, d.Shutdown(t.Context()))
+	require.NoError(t, d.ForceFlush(t.Context()))
+
+	d.OnStart(t.Context(), span.(ReadWriteSpan))
+	assert.Empty(t, d.ActiveSpans(), "spans are not tracked after shutdown")
+	span.End()
+}
+
+func TestLeakError(t *testing.T) {
+	tp := NewTracerProvider()
+	_, span := tp.Tracer(t.Name()).Start(
+		t.Context(),
+		"span",
+		trace.WithAttributes(attribute.String("key", "value"), attribute.Int("n", 1)),
+	)
+	defer span.End()
+
+	err := leakError{
+		span:    ActiveSpan{Span: span.(ReadOnlySpan), Stack: "main.main\n\tmain.go:1\n"},
+		timeout: time.Minute,
+	}
+	want := "span \"span\" not ended after 1m0s {key=value,n=1}, started at:\nmain.main\n\tmain.go:1\n"
+	assert.Equal(t, want, err.Error())
+}
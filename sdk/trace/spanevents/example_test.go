@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanevents_test
+
+import (
+	"go.opentelemetry.io/otel/log/global"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/spanevents"
+)
+
+func Example() {
+	// Existing span processor that exports spans.
+	var processor sdktrace.SpanProcessor = sdktrace.NewBatchSpanProcessor(nil)
+
+	// Wrap the span processor so that the events of spans are emitted as log
+	// records by the global logger provider, and removed from the exported
+	// spans.
+	processor = spanevents.NewProcessor(
+		processor,
+		global.GetLoggerProvider(),
+		spanevents.WithStripEvents(),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Trace SDK using the WithSpanProcessor option.
+	_ = sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+	)
+}
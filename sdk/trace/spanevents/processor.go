@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spanevents provides a span processor that converts the events of
+// spans into log records.
+//
+// Span events are moving toward the Logs API: an event is a log record with
+// an event name. Use [Processor] to emit the events of spans as log records
+// that carry the trace context of their span, and optionally to remove the
+// events from the spans that are exported.
+package spanevents // import "go.opentelemetry.io/otel/sdk/trace/spanevents"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Processor is a [sdktrace.SpanProcessor] that emits the events of ended
+// spans as log records, and passes the spans to the span processor it wraps.
+//
+// Each event is emitted as a log record by a [log.Logger] with the
+// instrumentation scope of its span. The log record has the name of the event
+// as event name, the time of the event as timestamp, the attributes of the
+// event, and the trace context of the span. Exception events, recorded with
+// [trace.Span.RecordError], have the [log.SeverityError] severity. The other
+// events have the [log.SeverityInfo] severity.
+//
+// Use [NewProcessor] to create a Processor.
+type Processor struct {
+	next     sdktrace.SpanProcessor
+	provider log.LoggerProvider
+	strip    bool
+}
+
+var _ sdktrace.SpanProcessor = (*Processor)(nil)
+
+// NewProcessor returns a new Processor that emits the events of spans with
+// the loggers of provider, and passes the spans to next. If next is nil, the
+// spans are not passed on.
+func NewProcessor(next sdktrace.SpanProcessor, provider log.LoggerProvider, opts ...Option) *Processor {
+	var cfg config
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &Processor{next: next, provider: provider, strip: cfg.strip}
+}
+
+// OnStart passes s to the wrapped span processor.
+func (p *Processor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	if p.next != nil {
+		p.next.OnStart(parent, s)
+	}
+}
+
+// OnEnd emits the events of s as log records, and passes s to the wrapped
+// span processor. If the events are stripped, the span passed only has the
+// events that were not emitted because the logger is not enabled for them.
+func (p *Processor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if events := s.Events(); len(events) > 0 {
+		kept := p.emit(s, events)
+		if p.strip && len(kept) < len(events) {
+			s = strippedSpan{ReadOnlySpan: s, events: kept, stripped: len(events) - len(kept)}
+		}
+	}
+	if p.next != nil {
+		p.next.OnEnd(s)
+	}
+}
+
+// emit emits events of s as log records, and returns the events that were not
+// emitted.
+func (p *Processor) emit(s sdktrace.ReadOnlySpan, events []sdktrace.Event) []sdktrace.Event {
+	scope := s.InstrumentationScope()
+	logger := p.provider.Logger(
+		scope.Name,
+		log.WithInstrumentationVersion(scope.Version),
+		log.WithSchemaURL(scope.SchemaURL),
+		log.WithInstrumentationAttributeSet(scope.Attributes),
+	)
+	ctx := trace.ContextWithSpanContext(context.Background(), s.SpanContext())
+
+	var skipped []sdktrace.Event
+	for _, e := range events {
+		severity := log.SeverityInfo
+		if e.Name == semconv.ExceptionEventName {
+			severity = log.SeverityError
+		}
+		if !logger.Enabled(ctx, log.EnabledParameters{Severity: severity, EventName: e.Name}) {
+			skipped = append(skipped, e)
+			continue
+		}
+
+		var r log.Record
+		r.SetEventName(e.Name)
+		r.SetTimestamp(e.Time)
+		r.SetSeverity(severity)
+		r.AddAttributes(e.Attributes...)
+		logger.Emit(ctx, r)
+	}
+	return skipped
+}
+
+// Shutdown shuts down the wrapped span processor.
+func (p *Processor) Shutdown(ctx context.Context) error {
+	if p.next == nil {
+		return nil
+	}
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped span processor.
+func (p *Processor) ForceFlush(ctx context.Context) error {
+	if p.next == nil {
+		return nil
+	}
+	return p.next.ForceFlush(ctx)
+}
+
+// strippedSpan is a span without the events emitted as log records.
+type strippedSpan struct {
+	sdktrace.ReadOnlySpan
+
+	// events are the events that were not emitted.
+	events []sdktrace.Event
+	// stripped is the number of events removed.
+	stripped int
+}
+
+func (s strippedSpan) Events() []sdktrace.Event {
+	return s.events
+}
+
+// DroppedEvents returns the number of events dropped from the span, including
+// the ones removed as they were emitted as log records.
+func (s strippedSpan) DroppedEvents() int {
+	return s.ReadOnlySpan.DroppedEvents() + s.stripped
+}
+
+type config struct {
+	strip bool
+}
+
+// Option configures a [Processor].
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithStripEvents returns an Option that makes a [Processor] remove the
+// events emitted as log records from the spans it passes to the span
+// processor it wraps, so they are only exported as log records. The events
+// not emitted, because the logger is not enabled for them, are kept. The
+// removed events are counted in the dropped events count of the spans.
+//
+// By default, the spans are passed with their events.
+func WithStripEvents() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.strip = true
+		return cfg
+	})
+}
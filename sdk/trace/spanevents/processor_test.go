@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanevents
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setup(t *testing.T, rec *logtest.Recorder, opts ...Option) (trace.Tracer, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exp := tracetest.NewInMemoryExporter()
+	p := NewProcessor(sdktrace.NewSimpleSpanProcessor(exp), rec, opts...)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	return tp.Tracer("scope", trace.WithInstrumentationVersion("v1")), exp
+}
+
+func TestProcessor(t *testing.T) {
+	rec := logtest.NewRecorder()
+	tracer, exp := setup(t, rec)
+
+	ts := time.Unix(1, 0)
+	_, span := tracer.Start(t.Context(), "span")
+	span.AddEvent("event", trace.WithTimestamp(ts), trace.WithAttributes(attribute.String("k", "v")))
+	span.RecordError(errors.New("boom"), trace.WithTimestamp(ts.Add(time.Second)))
+	span.End()
+
+	scope := logtest.Scope{Name: "scope", Version: "v1"}
+	got := rec.Result()
+	require.Len(t, got, 1)
+	require.Len(t, got[scope], 2)
+
+	event := got[scope][0]
+	assert.Equal(t, "event", event.EventName)
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, log.SeverityInfo, event.Severity)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("k", "v")}, event.Attributes)
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(event.Context))
+
+	exception := got[scope][1]
+	assert.Equal(t, semconv.ExceptionEventName, exception.EventName)
+	assert.Equal(t, ts.Add(time.Second), exception.Timestamp)
+	assert.Equal(t, log.SeverityError, exception.Severity)
+	assert.Contains(t, exception.Attributes, semconv.ExceptionMessage("boom"))
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(exception.Context))
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Len(t, spans[0].Events, 2, "events are kept")
+}
+
+func TestProcessorStripEvents(t *testing.T) {
+	rec := logtest.NewRecorder()
+	tracer, exp := setup(t, rec, WithStripEvents())
+
+	_, span := tracer.Start(t.Context(), "span", trace.WithAttributes(attribute.Int("n", 1)))
+	span.AddEvent("event")
+	span.End()
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Empty(t, spans[0].Events)
+	assert.Equal(t, 1, spans[0].DroppedEvents, "stripped events are dropped")
+	assert.Equal(t, "span", spans[0].Name)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("n", 1)}, spans[0].Attributes)
+
+	assert.Len(t, rec.Result()[logtest.Scope{Name: "scope", Version: "v1"}], 1)
+}
+
+func TestProcessorDisabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, p log.EnabledParameters) bool {
+		return p.Severity >= log.SeverityError
+	}))
+	tracer, _ := setup(t, rec)
+
+	_, span := tracer.Start(t.Context(), "span")
+	span.AddEvent("event")
+	span.RecordError(errors.New("boom"))
+	span.End()
+
+	records := rec.Result()[logtest.Scope{Name: "scope", Version: "v1"}]
+	require.Len(t, records, 1)
+	assert.Equal(t, semconv.ExceptionEventName, records[0].EventName)
+}
+
+func TestProcessorStripEventsDisabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, p log.EnabledParameters) bool {
+		return p.Severity >= log.SeverityWarn
+	}))
+	tracer, exp := setup(t, rec, WithStripEvents())
+
+	_, span := tracer.Start(t.Context(), "span")
+	span.AddEvent("event")
+	span.RecordError(errors.New("boom"))
+	span.End()
+
+	records := rec.Result()[logtest.Scope{Name: "scope", Version: "v1"}]
+	require.Len(t, records, 1)
+	assert.Equal(t, semconv.ExceptionEventName, records[0].EventName)
+
+	// The event not emitted as a log record is kept on the span.
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	require.Len(t, spans[0].Events, 1)
+	assert.Equal(t, "event", spans[0].Events[0].Name)
+	assert.Equal(t, 1, spans[0].DroppedEvents)
+}
+
+func TestProcessorStripEventsAllDisabled(t *testing.T) {
+	rec := logtest.NewRecorder(logtest.WithEnabledFunc(func(context.Context, log.EnabledParameters) bool {
+		return false
+	}))
+	tracer, exp := setup(t, rec, WithStripEvents())
+
+	_, span := tracer.Start(t.Context(), "span")
+	span.AddEvent("event")
+	span.End()
+
+	assert.Empty(t, rec.Result()[logtest.Scope{Name: "scope", Version: "v1"}])
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Len(t, spans[0].Events, 1, "events are kept")
+	assert.Zero(t, spans[0].DroppedEvents)
+}
+
+type spanProcessor struct {
+	started, ended int
+	err            error
+}
+
+func (p *spanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) { p.started++ }
+
+func (p *spanProcessor) OnEnd(sdktrace.ReadOnlySpan) { p.ended++ }
+
+func (p *spanProcessor) Shutdown(context.Context) error { return p.err }
+
+func (p *spanProcessor) ForceFlush(context.Context) error { return p.err }
+
+func TestProcessorWrapped(t *testing.T) {
+	next := &spanProcessor{err: errors.New("processor error")}
+	p := NewProcessor(next, logtest.NewRecorder())
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(p))
+
+	_, span := tp.Tracer("scope").Start(t.Context(), "span")
+	span.End()
+	assert.Equal(t, 1, next.started)
+	assert.Equal(t, 1, next.ended)
+	assert.ErrorIs(t, p.ForceFlush(t.Context()), next.err)
+	assert.ErrorIs(t, p.Shutdown(t.Context()), next.err)
+}
+
+func TestProcessorNilNext(t *testing.T) {
+	rec := logtest.NewRecorder()
+	p := NewProcessor(nil, rec)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(p))
+
+	_, span := tp.Tracer("scope").Start(t.Context(), "span")
+	span.AddEvent("event")
+	span.End()
+	assert.Len(t, rec.Result()[logtest.Scope{Name: "scope"}], 1)
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
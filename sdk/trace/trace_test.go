@@ -2084,6 +2084,76 @@ func TestWithIDGenerator(t *testing.T) {
 	}
 }
 
+type randomIDs struct {
+	IDGenerator
+
+	random bool
+}
+
+func (g randomIDs) RandomTraceIDs() bool { return g.random }
+
+type paramsSampler struct {
+	params []SamplingParameters
+}
+
+func (s *paramsSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	s.params = append(s.params, p)
+	return SamplingResult{Decision: RecordAndSample}
+}
+
+func (*paramsSampler) Description() string { return "paramsSampler" }
+
+func TestRandomTraceFlag(t *testing.T) {
+	remote := func(flags trace.TraceFlags) context.Context {
+		return trace.ContextWithRemoteSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: flags,
+			Remote:     true,
+		}))
+	}
+
+	tests := []struct {
+		name string
+		gen  IDGenerator
+		ctx  context.Context
+		opts []trace.SpanStartOption
+		want bool
+	}{
+		{name: "DefaultRoot", ctx: t.Context(), want: true},
+		{name: "CustomRoot", gen: &testIDGenerator{}, ctx: t.Context(), want: false},
+		{
+			name: "NotRandomRoot",
+			gen:  randomIDs{IDGenerator: &testIDGenerator{}, random: false},
+			ctx:  t.Context(),
+			want: false,
+		},
+		{
+			name: "RandomRoot",
+			gen:  randomIDs{IDGenerator: &testIDGenerator{}, random: true},
+			ctx:  t.Context(),
+			want: true,
+		},
+		{name: "RandomParent", gen: &testIDGenerator{}, ctx: remote(trace.FlagsRandom), want: true},
+		{name: "NotRandomParent", ctx: remote(0), want: false},
+		{name: "NewRoot", ctx: remote(0), opts: []trace.SpanStartOption{trace.WithNewRoot()}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler := new(paramsSampler)
+			tp := NewTracerProvider(WithSampler(sampler), WithIDGenerator(tt.gen))
+			_, span := tp.Tracer(t.Name()).Start(tt.ctx, "span", tt.opts...)
+			span.End()
+
+			assert.Equal(t, tt.want, span.SpanContext().TraceFlags().IsRandom(), "trace flags")
+			assert.True(t, span.SpanContext().IsSampled())
+			require.Len(t, sampler.params, 1)
+			assert.Equal(t, tt.want, sampler.params[0].RandomTraceID, "sampling parameters")
+		})
+	}
+}
+
 func TestIDsRoundTrip(t *testing.T) {
 	gen := defaultIDGenerator()
 
@@ -99,8 +99,11 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 	// on a unique span ID, even if the Span is non-recording.
 	var tid trace.TraceID
 	var sid trace.SpanID
+	flags := psc.TraceFlags()
 	if !psc.TraceID().IsValid() {
 		tid, sid = tr.provider.idGenerator.NewIDs(ctx)
+		// A new trace only inherits the random flag from its IDGenerator.
+		flags = flags.WithRandom(tr.provider.randomTraceIDs)
 	} else {
 		tid = psc.TraceID()
 		sid = tr.provider.idGenerator.NewSpanID(ctx, tid)
@@ -113,6 +116,7 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		Kind:          config.SpanKind(),
 		Attributes:    config.Attributes(),
 		Links:         config.Links(),
+		RandomTraceID: flags.IsRandom(),
 	})
 
 	scc := trace.SpanContextConfig{
@@ -121,9 +125,9 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		TraceState: samplingResult.Tracestate,
 	}
 	if isSampled(samplingResult) {
-		scc.TraceFlags = psc.TraceFlags() | trace.FlagsSampled
+		scc.TraceFlags = flags | trace.FlagsSampled
 	} else {
-		scc.TraceFlags = psc.TraceFlags() &^ trace.FlagsSampled
+		scc.TraceFlags = flags &^ trace.FlagsSampled
 	}
 	sc := trace.NewSpanContext(scc)
 
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 func ExampleSpanRecorder() {
@@ -35,3 +37,42 @@ func ExampleSpanRecorder() {
 	// Output:
 	// test-span
 }
+
+func ExampleAssertSpanTrees() {
+	ctx := context.Background()
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(trace.WithSyncer(exp))
+	defer tp.Shutdown(ctx) //nolint:errcheck // Example code, error handling omitted.
+
+	tracer := tp.Tracer("example/tree")
+	ctx, parent := tracer.Start(ctx, "parent", oteltrace.WithSpanKind(oteltrace.SpanKindServer))
+	_, child := tracer.Start(ctx, "child", oteltrace.WithAttributes(attribute.String("key", "value")))
+	child.End()
+	parent.End()
+
+	// In a test, pass the *testing.T instead.
+	t := &printT{}
+	ok := tracetest.AssertSpanTrees(t, exp.GetSpans(), tracetest.MatchSpan(
+		"parent",
+		tracetest.HasKind(oteltrace.SpanKindServer),
+		tracetest.HasChildren(
+			tracetest.MatchSpan("child", tracetest.HasAttributes(attribute.String("key", "value"))),
+		),
+	))
+	fmt.Println(ok)
+
+	// Render the span trees, as stored in golden files by AssertGoldenFile.
+	fmt.Print(tracetest.FormatSpanTrees(exp.GetSpans().Trees()))
+
+	// Output:
+	// true
+	// span "parent" kind=server
+	//   span "child" kind=internal {key=value}
+}
+
+type printT struct{}
+
+func (printT) Helper() {}
+
+func (printT) Error(args ...any) { fmt.Println(args...) }
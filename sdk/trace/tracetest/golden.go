@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// UpdateGoldenEnv is the environment variable that, if set to "1" or "true",
+// makes [AssertGoldenFile] write golden files instead of comparing to them.
+const UpdateGoldenEnv = "OTEL_GO_TRACETEST_UPDATE_GOLDEN"
+
+// FormatSpanTrees returns a text rendering of trees that does not contain
+// times or IDs. Each span is on its own line, indented below its parent, with
+// its kind, status, and attributes. Span events are listed below their span.
+//
+// Sibling spans are ordered by name, and by their order in trees if equal, so
+// the rendering of the same spans is stable across runs.
+func FormatSpanTrees(trees []*SpanTree) string {
+	var b strings.Builder
+	for _, t := range byName(trees) {
+		formatSpanTree(&b, t, 0)
+	}
+	return b.String()
+}
+
+func formatSpanTree(b *strings.Builder, t *SpanTree, depth int) {
+	indent := strings.Repeat("  ", depth)
+	span := t.Span
+
+	fmt.Fprintf(b, "%sspan %q kind=%s", indent, span.Name, span.SpanKind)
+	if span.Status.Code != codes.Unset {
+		fmt.Fprintf(b, " status=%s", formatStatus(span.Status))
+	}
+	if len(span.Attributes) > 0 {
+		b.WriteByte(' ')
+		b.WriteString(formatAttributes(span.Attributes))
+	}
+	b.WriteByte('\n')
+
+	for _, e := range span.Events {
+		fmt.Fprintf(b, "%s  event %q", indent, e.Name)
+		if len(e.Attributes) > 0 {
+			b.WriteByte(' ')
+			b.WriteString(formatAttributes(e.Attributes))
+		}
+		b.WriteByte('\n')
+	}
+	for _, c := range byName(t.Children) {
+		formatSpanTree(b, c, depth+1)
+	}
+}
+
+// formatAttributes returns attrs formatted as {k1=v1,k2=v2}, ordered by key.
+func formatAttributes(attrs []attribute.KeyValue) string {
+	set := attribute.NewSet(attrs...)
+	parts := make([]string, 0, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		parts = append(parts, fmt.Sprintf("%s=%s", kv.Key, kv.Value.String()))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func byName(trees []*SpanTree) []*SpanTree {
+	sorted := slices.Clone(trees)
+	slices.SortStableFunc(sorted, func(a, b *SpanTree) int {
+		return strings.Compare(a.Span.Name, b.Span.Name)
+	})
+	return sorted
+}
+
+// AssertGoldenFile asserts that the rendering of the span trees of spans by
+// [FormatSpanTrees] equals the content of the golden file at path.
+//
+// If the [UpdateGoldenEnv] environment variable is set to "1" or "true", the
+// golden file is written with the rendering instead, creating its directory
+// if needed.
+//
+// If the assertion fails, the differing lines are reported to t and false is
+// returned.
+func AssertGoldenFile(t TestingT, spans SpanStubs, path string) bool {
+	t.Helper()
+
+	got := FormatSpanTrees(spans.Trees())
+	if v := os.Getenv(UpdateGoldenEnv); v == "1" || strings.EqualFold(v, "true") {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Error(fmt.Sprintf("failed to create golden file directory: %v", err))
+			return false
+		}
+		if err := os.WriteFile(path, []byte(got), 0o600); err != nil {
+			t.Error(fmt.Sprintf("failed to write golden file: %v", err))
+			return false
+		}
+		return true
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // The path is provided by the test.
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Error(fmt.Sprintf("golden file %s does not exist, set %s=1 to create it", path, UpdateGoldenEnv))
+		} else {
+			t.Error(fmt.Sprintf("failed to read golden file: %v", err))
+		}
+		return false
+	}
+
+	want := string(data)
+	if got == want {
+		return true
+	}
+	t.Error(fmt.Sprintf(
+		"span trees do not match golden file %s (set %s=1 to update it):\n%s",
+		path,
+		UpdateGoldenEnv,
+		diffLines(want, got),
+	))
+	return false
+}
+
+// diffLines returns a line diff from want to got. Removed lines are prefixed
+// with "-", added lines with "+", and common lines with a space.
+func diffLines(want, got string) string {
+	a, b := lines(want), lines(got)
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and
+	// b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			out.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			out.WriteString("+ " + b[j] + "\n")
+			j++
+		default:
+			out.WriteString("- " + a[i] + "\n")
+			i++
+		}
+	}
+	return out.String()
+}
+
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSpanTrees(t *testing.T) {
+	want := `span "GET /users" kind=server status=Error {http.route=/users,http.status_code=500}
+  span "cache.get" kind=internal
+  span "db.query" kind=client status=Error("timeout") {db.system=postgresql}
+    event "exception" {exception.message=timeout}
+span "job" kind=internal
+`
+	assert.Equal(t, want, FormatSpanTrees(recordSpans(t).Trees()))
+}
+
+func TestAssertGoldenFile(t *testing.T) {
+	spans := recordSpans(t)
+
+	r := new(recordingT)
+	assert.True(t, AssertGoldenFile(r, spans, filepath.Join("testdata", "spans.golden")))
+	assert.Empty(t, r.errs)
+}
+
+func TestAssertGoldenFileMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.golden")
+	golden := `span "GET /users" kind=server
+span "job" kind=internal
+`
+	require.NoError(t, os.WriteFile(path, []byte(golden), 0o600))
+
+	r := new(recordingT)
+	assert.False(t, AssertGoldenFile(r, recordSpans(t)[3:], path))
+	require.Len(t, r.errs, 1)
+	assert.Contains(t, r.errs[0], "- span \"GET /users\" kind=server\n  span \"job\" kind=internal\n")
+}
+
+func TestAssertGoldenFileMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.golden")
+
+	r := new(recordingT)
+	assert.False(t, AssertGoldenFile(r, recordSpans(t), path))
+	require.Len(t, r.errs, 1)
+	assert.Contains(t, r.errs[0], UpdateGoldenEnv+"=1")
+}
+
+func TestAssertGoldenFileUpdate(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "1")
+	path := filepath.Join(t.TempDir(), "dir", "spans.golden")
+	spans := recordSpans(t)
+
+	r := new(recordingT)
+	assert.True(t, AssertGoldenFile(r, spans, path))
+	assert.Empty(t, r.errs)
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, FormatSpanTrees(spans.Trees()), string(got))
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ sdktrace.RandomTraceIDGenerator = (*IDGenerator)(nil)
+
+// IDGenerator is a deterministic [sdktrace.IDGenerator]. Two IDGenerators
+// created with the same options generate the same sequence of IDs, so the
+// IDs of spans can be reproduced across test runs.
+//
+// By default, IDs are generated from a pseudo-random source seeded with 0.
+// Use [WithSeed] to set another seed, or [WithSequentialIDs] to generate
+// counting IDs that are easier to read.
+//
+// Use it with [sdktrace.WithIDGenerator].
+type IDGenerator struct {
+	mu   sync.Mutex
+	rng  *rand.Rand
+	next uint64
+
+	sequential bool
+	now        func() time.Time
+}
+
+// NewIDGenerator returns a new IDGenerator configured with opts.
+func NewIDGenerator(opts ...IDGeneratorOption) *IDGenerator {
+	var cfg idGeneratorConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &IDGenerator{
+		rng:        rand.New(rand.NewPCG(cfg.seed, cfg.seed)), //nolint:gosec // Deterministic IDs are intended.
+		sequential: cfg.sequential,
+		now:        cfg.now,
+	}
+}
+
+// NewIDs returns a new trace ID and span ID.
+func (g *IDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var tid trace.TraceID
+	for !tid.IsValid() {
+		if !g.sequential {
+			binary.BigEndian.PutUint64(tid[:8], g.uint64())
+		}
+		binary.BigEndian.PutUint64(tid[8:], g.uint64())
+		if g.now != nil {
+			sec := g.now().Unix()
+			binary.BigEndian.PutUint32(tid[:4], uint32(sec)) //nolint:gosec // Wraps in 2106, as intended.
+		}
+	}
+	return tid, g.spanID()
+}
+
+// NewSpanID returns a new span ID.
+func (g *IDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.spanID()
+}
+
+// RandomTraceIDs reports whether the trace IDs generated by g have the
+// randomness required by the [trace.FlagsRandom] flag. That is the case
+// unless g generates sequential IDs. A [sdktrace.TracerProvider] uses it to
+// set the flag for root spans.
+//
+// Even if the IDs are random, they are only pseudo-random from a fixed seed,
+// which is suitable for testing only.
+func (g *IDGenerator) RandomTraceIDs() bool {
+	return !g.sequential
+}
+
+func (g *IDGenerator) spanID() trace.SpanID {
+	var sid trace.SpanID
+	for !sid.IsValid() {
+		binary.BigEndian.PutUint64(sid[:], g.uint64())
+	}
+	return sid
+}
+
+// uint64 returns the next value of the sequence of g. It needs to be called
+// with the lock of g held.
+func (g *IDGenerator) uint64() uint64 {
+	if g.sequential {
+		g.next++
+		return g.next
+	}
+	return g.rng.Uint64()
+}
+
+type idGeneratorConfig struct {
+	seed       uint64
+	sequential bool
+	now        func() time.Time
+}
+
+// IDGeneratorOption configures an [IDGenerator].
+type IDGeneratorOption interface {
+	apply(idGeneratorConfig) idGeneratorConfig
+}
+
+type idGeneratorOptionFunc func(idGeneratorConfig) idGeneratorConfig
+
+func (fn idGeneratorOptionFunc) apply(cfg idGeneratorConfig) idGeneratorConfig {
+	return fn(cfg)
+}
+
+// WithSeed returns an IDGeneratorOption that seeds the pseudo-random source
+// of IDs with seed.
+//
+// By default, the seed 0 is used.
+func WithSeed(seed uint64) IDGeneratorOption {
+	return idGeneratorOptionFunc(func(cfg idGeneratorConfig) idGeneratorConfig {
+		cfg.seed = seed
+		return cfg
+	})
+}
+
+// WithSequentialIDs returns an IDGeneratorOption that makes an [IDGenerator]
+// count IDs instead of generating them pseudo-randomly. The first trace ID is
+// 00000000000000000000000000000001, and the first span ID is
+// 0000000000000002. All IDs share the same count, so every ID is unique.
+//
+// Sequential trace IDs are not random, so [IDGenerator.RandomTraceIDs]
+// returns false and the [trace.FlagsRandom] flag must not be set for them.
+func WithSequentialIDs() IDGeneratorOption {
+	return idGeneratorOptionFunc(func(cfg idGeneratorConfig) idGeneratorConfig {
+		cfg.sequential = true
+		return cfg
+	})
+}
+
+// WithTimePrefix returns an IDGeneratorOption that makes an [IDGenerator]
+// set the first 4 bytes of trace IDs to the Unix time in seconds returned by
+// now, as required by some tracing backends, e.g. AWS X-Ray.
+//
+// The remaining bytes are still random unless [WithSequentialIDs] is used,
+// so the trace IDs keep the randomness required by the [trace.FlagsRandom]
+// flag.
+func WithTimePrefix(now func() time.Time) IDGeneratorOption {
+	return idGeneratorOptionFunc(func(cfg idGeneratorConfig) idGeneratorConfig {
+		cfg.now = now
+		return cfg
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestIDGeneratorDeterministic(t *testing.T) {
+	ids := func(g *IDGenerator) []string {
+		var out []string
+		for range 3 {
+			tid, sid := g.NewIDs(t.Context())
+			out = append(out, tid.String(), sid.String(), g.NewSpanID(t.Context(), tid).String())
+		}
+		return out
+	}
+
+	first := ids(NewIDGenerator())
+	assert.Equal(t, first, ids(NewIDGenerator()), "same seed")
+	assert.Equal(t, first, ids(NewIDGenerator(WithSeed(0))), "default seed")
+	assert.NotEqual(t, first, ids(NewIDGenerator(WithSeed(1))), "other seed")
+
+	seen := make(map[string]bool)
+	for _, id := range first {
+		assert.False(t, seen[id], "duplicate ID %s", id)
+		seen[id] = true
+	}
+	assert.True(t, NewIDGenerator().RandomTraceIDs())
+}
+
+func TestIDGeneratorSequential(t *testing.T) {
+	g := NewIDGenerator(WithSequentialIDs())
+	assert.False(t, g.RandomTraceIDs())
+
+	tid, sid := g.NewIDs(t.Context())
+	assert.Equal(t, "00000000000000000000000000000001", tid.String())
+	assert.Equal(t, "0000000000000002", sid.String())
+	assert.Equal(t, "0000000000000003", g.NewSpanID(t.Context(), tid).String())
+
+	tid, sid = g.NewIDs(t.Context())
+	assert.Equal(t, "00000000000000000000000000000004", tid.String())
+	assert.Equal(t, "0000000000000005", sid.String())
+}
+
+func TestIDGeneratorTimePrefix(t *testing.T) {
+	now := func() time.Time { return time.Unix(0x5f5e1000, 0) }
+
+	g := NewIDGenerator(WithTimePrefix(now))
+	assert.True(t, g.RandomTraceIDs())
+	tid, _ := g.NewIDs(t.Context())
+	assert.Equal(t, "5f5e1000", tid.String()[:8])
+	assert.NotEqual(t, "0000000000000000", tid.String()[8:16], "random after the prefix")
+
+	g = NewIDGenerator(WithTimePrefix(now), WithSequentialIDs())
+	tid, _ = g.NewIDs(t.Context())
+	assert.Equal(t, "5f5e1000000000000000000000000001", tid.String())
+}
+
+func TestIDGeneratorWithTracerProvider(t *testing.T) {
+	run := func() SpanStubs {
+		exp := NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(
+			sdktrace.WithSyncer(exp),
+			sdktrace.WithIDGenerator(NewIDGenerator(WithSequentialIDs())),
+		)
+		ctx, parent := tp.Tracer("test").Start(t.Context(), "parent")
+		_, child := tp.Tracer("test").Start(ctx, "child")
+		child.End()
+		parent.End()
+		return exp.GetSpans()
+	}
+
+	spans := run()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "00000000000000000000000000000001", spans[0].SpanContext.TraceID().String())
+	assert.Equal(t, trace.SpanID{7: 3}, spans[0].SpanContext.SpanID())
+	assert.Equal(t, trace.SpanID{7: 2}, spans[1].SpanContext.SpanID())
+	assert.False(t, spans[1].SpanContext.TraceFlags().IsRandom(), "sequential trace IDs are not random")
+
+	again := run()
+	for i := range spans {
+		assert.Equal(t, spans[i].SpanContext, again[i].SpanContext)
+	}
+}
+
+func TestIDGeneratorConcurrentSafe(t *testing.T) {
+	g := NewIDGenerator()
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 10 {
+				tid, _ := g.NewIDs(t.Context())
+				_ = g.NewSpanID(t.Context(), tid)
+			}
+		})
+	}
+	wg.Wait()
+}
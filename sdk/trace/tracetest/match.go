@@ -0,0 +1,337 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestingT is an interface that implements [testing.T], but without the
+// private method of [testing.TB], so other testing packages can rely on it as
+// well.
+// The methods in this interface must match the [testing.TB] interface.
+type TestingT interface {
+	Helper()
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+
+	Error(...any)
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+}
+
+// SpanMatcher matches a span by its name and the properties set with
+// [SpanMatcherOption]s. Properties that are not set are not compared. Times
+// and IDs are never compared.
+type SpanMatcher struct {
+	name string
+
+	kind       trace.SpanKind
+	status     *tracesdk.Status
+	attributes []attribute.KeyValue
+	events     []EventMatcher
+	children   []SpanMatcher
+
+	matchEvents   bool
+	matchChildren bool
+}
+
+// MatchSpan returns a SpanMatcher that matches spans named name with all the
+// properties set by opts.
+func MatchSpan(name string, opts ...SpanMatcherOption) SpanMatcher {
+	m := SpanMatcher{name: name}
+	for _, opt := range opts {
+		m = opt.apply(m)
+	}
+	return m
+}
+
+// SpanMatcherOption sets a property a [SpanMatcher] matches.
+type SpanMatcherOption interface {
+	apply(SpanMatcher) SpanMatcher
+}
+
+type spanMatcherOptionFunc func(SpanMatcher) SpanMatcher
+
+func (fn spanMatcherOptionFunc) apply(m SpanMatcher) SpanMatcher {
+	return fn(m)
+}
+
+// HasKind returns a SpanMatcherOption that matches spans of kind.
+func HasKind(kind trace.SpanKind) SpanMatcherOption {
+	return spanMatcherOptionFunc(func(m SpanMatcher) SpanMatcher {
+		m.kind = kind
+		return m
+	})
+}
+
+// HasStatus returns a SpanMatcherOption that matches spans with the status
+// code and description.
+func HasStatus(code codes.Code, description string) SpanMatcherOption {
+	return spanMatcherOptionFunc(func(m SpanMatcher) SpanMatcher {
+		m.status = &tracesdk.Status{Code: code, Description: description}
+		return m
+	})
+}
+
+// HasAttributes returns a SpanMatcherOption that matches spans that have all
+// the attributes attrs. Spans can have other attributes as well.
+func HasAttributes(attrs ...attribute.KeyValue) SpanMatcherOption {
+	return spanMatcherOptionFunc(func(m SpanMatcher) SpanMatcher {
+		m.attributes = append(m.attributes, attrs...)
+		return m
+	})
+}
+
+// HasEvents returns a SpanMatcherOption that matches spans with exactly the
+// events matched by events, in order. Passing no events matches spans without
+// events.
+func HasEvents(events ...EventMatcher) SpanMatcherOption {
+	return spanMatcherOptionFunc(func(m SpanMatcher) SpanMatcher {
+		m.events = events
+		m.matchEvents = true
+		return m
+	})
+}
+
+// HasChildren returns a SpanMatcherOption that matches spans with exactly the
+// child spans matched by children, in any order. Passing no children matches
+// spans without children.
+func HasChildren(children ...SpanMatcher) SpanMatcherOption {
+	return spanMatcherOptionFunc(func(m SpanMatcher) SpanMatcher {
+		m.children = children
+		m.matchChildren = true
+		return m
+	})
+}
+
+// EventMatcher matches a span event by its name and attributes. The time of
+// the event is not compared.
+type EventMatcher struct {
+	name       string
+	attributes []attribute.KeyValue
+}
+
+// MatchEvent returns an EventMatcher that matches events named name that have
+// all the attributes attrs. Events can have other attributes as well.
+func MatchEvent(name string, attrs ...attribute.KeyValue) EventMatcher {
+	return EventMatcher{name: name, attributes: attrs}
+}
+
+// Matches reports whether the span tree t is matched by m.
+func (m SpanMatcher) Matches(t *SpanTree) bool {
+	return t != nil && len(m.diff(nil, t)) == 0
+}
+
+// AssertSpanTrees asserts that the span trees of spans are matched by want,
+// in any order. There needs to be exactly one span tree for each matcher in
+// want.
+//
+// If the assertion fails, the differences and the actual span trees are
+// reported to t and false is returned.
+func AssertSpanTrees(t TestingT, spans SpanStubs, want ...SpanMatcher) bool {
+	t.Helper()
+
+	trees := spans.Trees()
+	diffs := diffTrees(nil, want, trees)
+	if len(diffs) == 0 {
+		return true
+	}
+
+	var b strings.Builder
+	b.WriteString("span trees do not match:")
+	for _, d := range diffs {
+		b.WriteString("\n\t")
+		b.WriteString(d)
+	}
+	b.WriteString("\nactual span trees:\n")
+	b.WriteString(FormatSpanTrees(trees))
+	t.Error(b.String())
+	return false
+}
+
+// diff returns the differences of t from m. The path holds the names of the
+// ancestors of t.
+func (m SpanMatcher) diff(path []string, t *SpanTree) []string {
+	span := t.Span
+	path = append(path[:len(path):len(path)], fmt.Sprintf("%q", span.Name))
+	prefix := strings.Join(path, " > ") + ": "
+
+	var diffs []string
+	if span.Name != m.name {
+		diffs = append(diffs, fmt.Sprintf("%sexpected name %q", prefix, m.name))
+	}
+	if m.kind != trace.SpanKindUnspecified && span.SpanKind != m.kind {
+		diffs = append(diffs, fmt.Sprintf("%skind: expected %s, got %s", prefix, m.kind, span.SpanKind))
+	}
+	if m.status != nil && span.Status != *m.status {
+		diffs = append(diffs, fmt.Sprintf(
+			"%sstatus: expected %s, got %s",
+			prefix,
+			formatStatus(*m.status),
+			formatStatus(span.Status),
+		))
+	}
+	diffs = append(diffs, diffAttributes(prefix, m.attributes, span.Attributes)...)
+
+	if m.matchEvents {
+		diffs = append(diffs, diffEvents(prefix, m.events, span.Events)...)
+	}
+	if m.matchChildren {
+		diffs = append(diffs, diffTrees(path, m.children, t.Children)...)
+	}
+	return diffs
+}
+
+func diffAttributes(prefix string, want, got []attribute.KeyValue) []string {
+	var diffs []string
+	for _, w := range want {
+		i := indexKey(got, w.Key)
+		if i < 0 {
+			diffs = append(diffs, fmt.Sprintf("%smissing attribute %s=%s", prefix, w.Key, w.Value.String()))
+			continue
+		}
+		if got[i].Value != w.Value {
+			diffs = append(diffs, fmt.Sprintf(
+				"%sattribute %s: expected %s, got %s",
+				prefix,
+				w.Key,
+				w.Value.String(),
+				got[i].Value.String(),
+			))
+		}
+	}
+	return diffs
+}
+
+// indexKey returns the index of the last attribute in attrs with key, or -1.
+// The last attribute is the one in effect if a key is set more than once.
+func indexKey(attrs []attribute.KeyValue, key attribute.Key) int {
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if attrs[i].Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func diffEvents(prefix string, want []EventMatcher, got []tracesdk.Event) []string {
+	if len(want) != len(got) {
+		wantNames := make([]string, len(want))
+		for i, e := range want {
+			wantNames[i] = fmt.Sprintf("%q", e.name)
+		}
+		gotNames := make([]string, len(got))
+		for i, e := range got {
+			gotNames[i] = fmt.Sprintf("%q", e.Name)
+		}
+		return []string{fmt.Sprintf(
+			"%sevents: expected [%s], got [%s]",
+			prefix,
+			strings.Join(wantNames, ", "),
+			strings.Join(gotNames, ", "),
+		)}
+	}
+
+	var diffs []string
+	for i, w := range want {
+		if got[i].Name != w.name {
+			diffs = append(diffs, fmt.Sprintf("%sevent %d: expected name %q, got %q", prefix, i, w.name, got[i].Name))
+			continue
+		}
+		eventPrefix := fmt.Sprintf("%sevent %q: ", prefix, w.name)
+		diffs = append(diffs, diffAttributes(eventPrefix, w.attributes, got[i].Attributes)...)
+	}
+	return diffs
+}
+
+// diffTrees returns the differences of trees from want. Each matcher needs to
+// match a distinct tree, in any order.
+func diffTrees(path []string, want []SpanMatcher, trees []*SpanTree) []string {
+	if len(want) == len(trees) && matchAll(path, want, trees) {
+		return nil
+	}
+
+	// Explain the mismatch by pairing matchers with the trees of the same
+	// name, preferring trees they fully match.
+	prefix := ""
+	if len(path) > 0 {
+		prefix = strings.Join(path, " > ") + ": "
+	}
+	used := make([]bool, len(trees))
+	pairs := make([]int, len(want))
+	for i, m := range want {
+		pairs[i] = -1
+		for j, t := range trees {
+			if used[j] || t.Span.Name != m.name {
+				continue
+			}
+			if pairs[i] < 0 {
+				pairs[i] = j
+			}
+			if len(m.diff(path, t)) == 0 {
+				pairs[i] = j
+				break
+			}
+		}
+		if pairs[i] >= 0 {
+			used[pairs[i]] = true
+		}
+	}
+
+	var diffs []string
+	for i, m := range want {
+		if pairs[i] < 0 {
+			diffs = append(diffs, fmt.Sprintf("%smissing span %q", prefix, m.name))
+			continue
+		}
+		diffs = append(diffs, m.diff(path, trees[pairs[i]])...)
+	}
+	for j, t := range trees {
+		if !used[j] {
+			diffs = append(diffs, fmt.Sprintf("%sunexpected span %q", prefix, t.Span.Name))
+		}
+	}
+	return diffs
+}
+
+// matchAll reports whether each matcher in want matches a distinct tree in
+// trees.
+func matchAll(path []string, want []SpanMatcher, trees []*SpanTree) bool {
+	if len(want) > len(trees) {
+		return false
+	}
+	used := make([]bool, len(trees))
+	var solve func(i int) bool
+	solve = func(i int) bool {
+		if i == len(want) {
+			return true
+		}
+		for j, t := range trees {
+			if used[j] || t.Span.Name != want[i].name || len(want[i].diff(path, t)) != 0 {
+				continue
+			}
+			used[j] = true
+			if solve(i + 1) {
+				return true
+			}
+			used[j] = false
+		}
+		return false
+	}
+	return solve(0)
+}
+
+func formatStatus(s tracesdk.Status) string {
+	if s.Description == "" {
+		return s.Code.String()
+	}
+	return fmt.Sprintf("%s(%q)", s.Code, s.Description)
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordingT is a TestingT that records the errors reported to it.
+type recordingT struct {
+	errs []string
+}
+
+func (*recordingT) Helper() {}
+
+func (r *recordingT) Error(args ...any) {
+	r.errs = append(r.errs, fmt.Sprint(args...))
+}
+
+func wantRequest(opts ...SpanMatcherOption) SpanMatcher {
+	return MatchSpan("GET /users", append([]SpanMatcherOption{
+		HasKind(trace.SpanKindServer),
+		HasStatus(codes.Error, ""),
+		HasAttributes(attribute.String("http.route", "/users")),
+		HasChildren(
+			MatchSpan("cache.get"),
+			MatchSpan(
+				"db.query",
+				HasKind(trace.SpanKindClient),
+				HasStatus(codes.Error, "timeout"),
+				HasEvents(MatchEvent("exception", attribute.String("exception.message", "timeout"))),
+			),
+		),
+	}, opts...)...)
+}
+
+func TestAssertSpanTrees(t *testing.T) {
+	spans := recordSpans(t)
+
+	r := new(recordingT)
+	assert.True(t, AssertSpanTrees(r, spans, MatchSpan("job"), wantRequest()))
+	assert.Empty(t, r.errs)
+}
+
+func TestAssertSpanTreesFailure(t *testing.T) {
+	spans := recordSpans(t)
+
+	tests := []struct {
+		name string
+		want []SpanMatcher
+		errs []string
+	}{
+		{
+			name: "MissingTree",
+			want: []SpanMatcher{wantRequest()},
+			errs: []string{`unexpected span "job"`},
+		},
+		{
+			name: "UnexpectedTree",
+			want: []SpanMatcher{wantRequest(), MatchSpan("job"), MatchSpan("other")},
+			errs: []string{`missing span "other"`},
+		},
+		{
+			name: "Kind",
+			want: []SpanMatcher{MatchSpan("job", HasKind(trace.SpanKindClient)), wantRequest()},
+			errs: []string{`"job": kind: expected client, got internal`},
+		},
+		{
+			name: "Status",
+			want: []SpanMatcher{MatchSpan("job", HasStatus(codes.Error, "boom")), wantRequest()},
+			errs: []string{`"job": status: expected Error("boom"), got Unset`},
+		},
+		{
+			name: "Attribute",
+			want: []SpanMatcher{
+				MatchSpan("job"),
+				wantRequest(HasAttributes(attribute.Int("http.status_code", 200), attribute.Bool("missing", true))),
+			},
+			errs: []string{
+				`"GET /users": attribute http.status_code: expected 200, got 500`,
+				`"GET /users": missing attribute missing=true`,
+			},
+		},
+		{
+			name: "Child",
+			want: []SpanMatcher{
+				MatchSpan("job"),
+				MatchSpan("GET /users", HasChildren(
+					MatchSpan("db.query", HasKind(trace.SpanKindServer)),
+					MatchSpan("queue.publish"),
+				)),
+			},
+			errs: []string{
+				`"GET /users" > "db.query": kind: expected server, got client`,
+				`"GET /users": missing span "queue.publish"`,
+				`"GET /users": unexpected span "cache.get"`,
+			},
+		},
+		{
+			name: "Events",
+			want: []SpanMatcher{
+				MatchSpan("job", HasEvents(MatchEvent("start"))),
+				MatchSpan("GET /users", HasChildren(
+					MatchSpan("cache.get"),
+					MatchSpan("db.query", HasEvents(
+						MatchEvent("exception", attribute.String("exception.message", "x")),
+					)),
+				)),
+			},
+			errs: []string{
+				`"job": events: expected ["start"], got []`,
+				`"GET /users" > "db.query": event "exception": attribute exception.message: expected x, got timeout`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := new(recordingT)
+			assert.False(t, AssertSpanTrees(r, spans, tt.want...))
+			require.Len(t, r.errs, 1)
+			for _, e := range tt.errs {
+				assert.Contains(t, r.errs[0], "\n\t"+e+"\n")
+			}
+			assert.Contains(t, r.errs[0], "actual span trees:\n"+FormatSpanTrees(spans.Trees()))
+		})
+	}
+}
+
+func TestSpanMatcherMatchesChildrenInAnyOrder(t *testing.T) {
+	root := &SpanTree{Children: []*SpanTree{
+		{Span: SpanStub{Name: "a", Attributes: []attribute.KeyValue{attribute.Int("n", 1)}}},
+		{Span: SpanStub{Name: "a", Attributes: []attribute.KeyValue{attribute.Int("n", 2)}}},
+	}}
+
+	m := MatchSpan("", HasChildren(
+		MatchSpan("a"),
+		MatchSpan("a", HasAttributes(attribute.Int("n", 1))),
+	))
+	assert.True(t, m.Matches(root), "first matcher must not take the only span the second matches")
+
+	m = MatchSpan("", HasChildren(MatchSpan("a"), MatchSpan("a"), MatchSpan("a")))
+	assert.False(t, m.Matches(root))
+
+	m = MatchSpan("", HasChildren())
+	assert.False(t, m.Matches(root))
+
+	assert.True(t, MatchSpan("").Matches(root), "children are not compared if not set")
+	assert.False(t, MatchSpan("").Matches(nil))
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanTree is a span and the spans it is the parent of.
+type SpanTree struct {
+	Span     SpanStub
+	Children []*SpanTree
+}
+
+// Traces returns s grouped by trace ID. The traces and their spans keep the
+// order of s.
+func (s SpanStubs) Traces() []SpanStubs {
+	var (
+		out []SpanStubs
+		idx = make(map[trace.TraceID]int)
+	)
+	for _, span := range s {
+		id := span.SpanContext.TraceID()
+		i, ok := idx[id]
+		if !ok {
+			i = len(out)
+			idx[id] = i
+			out = append(out, nil)
+		}
+		out[i] = append(out[i], span)
+	}
+	return out
+}
+
+// Trees returns the spans of s resolved into trees by their parents.
+//
+// A span is a root of a tree if its parent is not in s. Roots and children
+// are ordered by their start time, and their order in s if equal.
+func (s SpanStubs) Trees() []*SpanTree {
+	type key struct {
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	}
+
+	nodes := make([]*SpanTree, len(s))
+	byID := make(map[key]*SpanTree, len(s))
+	for i, span := range s {
+		nodes[i] = &SpanTree{Span: span}
+		byID[key{span.SpanContext.TraceID(), span.SpanContext.SpanID()}] = nodes[i]
+	}
+
+	var roots []*SpanTree
+	for _, n := range nodes {
+		parent, ok := byID[key{n.Span.Parent.TraceID(), n.Span.Parent.SpanID()}]
+		if !n.Span.Parent.IsValid() || !ok || parent == n {
+			roots = append(roots, n)
+			continue
+		}
+		parent.Children = append(parent.Children, n)
+	}
+
+	sortTrees(roots)
+	return roots
+}
+
+func sortTrees(trees []*SpanTree) {
+	slices.SortStableFunc(trees, func(a, b *SpanTree) int {
+		return a.Span.StartTime.Compare(b.Span.StartTime)
+	})
+	for _, t := range trees {
+		sortTrees(t.Children)
+	}
+}
+
+// Find returns the first span tree in t, depth-first, with a span named name.
+// It returns nil if there is none.
+func (t *SpanTree) Find(name string) *SpanTree {
+	if t == nil {
+		return nil
+	}
+	if t.Span.Name == name {
+		return t
+	}
+	for _, c := range t.Children {
+		if found := c.Find(name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
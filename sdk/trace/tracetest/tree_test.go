@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans returns the spans of a server request with a database query
+// and a cache lookup, and of a separate background job.
+func recordSpans(t *testing.T) SpanStubs {
+	t.Helper()
+
+	exp := NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	tracer := tp.Tracer("tracetest")
+
+	ctx, root := tracer.Start(
+		t.Context(),
+		"GET /users",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("http.route", "/users"), attribute.Int("http.status_code", 500)),
+	)
+	_, db := tracer.Start(
+		ctx,
+		"db.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "postgresql")),
+	)
+	db.AddEvent("exception", trace.WithAttributes(attribute.String("exception.message", "timeout")))
+	db.SetStatus(codes.Error, "timeout")
+	db.End()
+	_, cache := tracer.Start(ctx, "cache.get")
+	cache.End()
+	root.SetStatus(codes.Error, "")
+	root.End()
+
+	_, job := tracer.Start(t.Context(), "job")
+	job.End()
+
+	spans := exp.GetSpans()
+	require.NoError(t, tp.Shutdown(t.Context()))
+	return spans
+}
+
+func TestSpanStubsTraces(t *testing.T) {
+	spans := recordSpans(t)
+	traces := spans.Traces()
+	require.Len(t, traces, 2)
+
+	assert.Len(t, traces[0], 3)
+	for _, s := range traces[0] {
+		assert.Equal(t, traces[0][0].SpanContext.TraceID(), s.SpanContext.TraceID())
+	}
+	assert.Len(t, traces[1], 1)
+	assert.Equal(t, "job", traces[1][0].Name)
+}
+
+func TestSpanStubsTrees(t *testing.T) {
+	spans := recordSpans(t)
+	trees := spans.Trees()
+	require.Len(t, trees, 2)
+
+	root := trees[0]
+	assert.Equal(t, "GET /users", root.Span.Name)
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, "db.query", root.Children[0].Span.Name)
+	assert.Equal(t, "cache.get", root.Children[1].Span.Name)
+	assert.Empty(t, root.Children[0].Children)
+
+	assert.Equal(t, "job", trees[1].Span.Name)
+	assert.Empty(t, trees[1].Children)
+}
+
+func TestSpanStubsTreesOrder(t *testing.T) {
+	traceID := trace.TraceID{1}
+	sc := func(id byte) trace.SpanContext {
+		return trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{id}})
+	}
+	start := time.Unix(100, 0)
+	spans := SpanStubs{
+		{Name: "late", SpanContext: sc(2), Parent: sc(1), StartTime: start.Add(2 * time.Second)},
+		{Name: "early", SpanContext: sc(3), Parent: sc(1), StartTime: start.Add(time.Second)},
+		{Name: "root", SpanContext: sc(1), StartTime: start},
+		{Name: "orphan", SpanContext: sc(4), Parent: sc(9), StartTime: start.Add(-time.Second)},
+	}
+
+	trees := spans.Trees()
+	require.Len(t, trees, 2)
+	assert.Equal(t, "orphan", trees[0].Span.Name, "span with missing parent is a root")
+	assert.Equal(t, "root", trees[1].Span.Name)
+	require.Len(t, trees[1].Children, 2)
+	assert.Equal(t, "early", trees[1].Children[0].Span.Name)
+	assert.Equal(t, "late", trees[1].Children[1].Span.Name)
+}
+
+func TestSpanTreeFind(t *testing.T) {
+	trees := recordSpans(t).Trees()
+
+	got := trees[0].Find("db.query")
+	require.NotNil(t, got)
+	assert.Equal(t, "db.query", got.Span.Name)
+	assert.Same(t, trees[0], trees[0].Find("GET /users"))
+	assert.Nil(t, trees[0].Find("job"))
+
+	var nilTree *SpanTree
+	assert.Nil(t, nilTree.Find("job"))
+}
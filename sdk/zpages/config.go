@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// DefaultSampleSize is the default number of sample spans kept per span
+	// name and latency bucket.
+	DefaultSampleSize = 5
+	// DefaultMaxSpanNames is the default maximum number of span names
+	// tracked.
+	DefaultMaxSpanNames = 1000
+)
+
+type config struct {
+	sampleSize   int
+	maxSpanNames int
+	sampler      sdktrace.Sampler
+	queues       []queue
+}
+
+type queue struct {
+	name   string
+	length func() int
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		sampleSize:   DefaultSampleSize,
+		maxSpanNames: DefaultMaxSpanNames,
+	}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option applies a configuration option to a [Handler].
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithSampleSize sets the number of sample spans kept per span name for the
+// active spans, each latency bucket, and the spans with errors. Non-positive
+// values are ignored.
+//
+// By default, [DefaultSampleSize] is used.
+func WithSampleSize(n int) Option {
+	return optionFunc(func(cfg config) config {
+		if n > 0 {
+			cfg.sampleSize = n
+		}
+		return cfg
+	})
+}
+
+// WithMaxSpanNames sets the maximum number of span names tracked. Spans with
+// other names are counted, but not tracked. Non-positive values are ignored.
+//
+// The number of active spans tracked is also limited to n times the sample
+// size. Spans started beyond this limit are counted, but not tracked as
+// active.
+//
+// By default, [DefaultMaxSpanNames] is used.
+func WithMaxSpanNames(n int) Option {
+	return optionFunc(func(cfg config) config {
+		if n > 0 {
+			cfg.maxSpanNames = n
+		}
+		return cfg
+	})
+}
+
+// WithSampler sets the sampler shown on the index page. It should be the
+// sampler of the TracerProvider the [Handler] is registered with.
+func WithSampler(s sdktrace.Sampler) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.sampler = s
+		return cfg
+	})
+}
+
+// WithQueue adds a queue named name to the index page, showing the current
+// number of items in the queue returned by length. Use it to watch the
+// queues of the application, such as the length of a buffered channel.
+//
+// The length function is called for every request of the index page, and
+// needs to be safe to call concurrently.
+func WithQueue(name string, length func() int) Option {
+	return optionFunc(func(cfg config) config {
+		if length != nil {
+			cfg.queues = append(cfg.queues, queue{name: name, length: length})
+		}
+		return cfg
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package zpages provides an [net/http.Handler] that shows the live state of
+the spans and metrics of a process, similar to the zPages of OpenCensus.
+
+A [Handler] is a [go.opentelemetry.io/otel/sdk/trace.SpanProcessor] that
+records a bounded summary of spans, and holds a
+[go.opentelemetry.io/otel/sdk/metric.Reader] to collect metrics on demand.
+Register it with both providers, and serve it at a path of your choosing:
+
+	h := zpages.NewHandler()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(h))
+	mp := metric.NewMeterProvider(metric.WithReader(h.Reader()))
+	http.Handle("/debug/", h)
+
+The Handler serves these pages relative to its path:
+
+  - tracez: the number of active spans, and of ended spans by latency and
+    error, per span name. Select a count to see sample spans.
+  - metricz: the current values of all metrics.
+
+Any other path serves an index of the pages, along with the configured
+sampler and queues.
+
+Only a fixed number of sample spans are kept per span name and latency
+bucket, and only a fixed number of span names and active spans are tracked,
+so the memory used is bounded. See [WithSampleSize] and [WithMaxSpanNames].
+
+The pages expose internal data of the process. Serve them only to trusted
+clients.
+*/
+package zpages
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages_test
+
+import (
+	"context"
+	"net/http"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/zpages"
+)
+
+func Example() {
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0.1))
+	h := zpages.NewHandler(zpages.WithSampler(sampler))
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithSpanProcessor(h),
+	)
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(h.Reader()))
+	defer func() { _ = mp.Shutdown(context.Background()) }()
+
+	// Serve the pages at /debug/, /debug/tracez, and /debug/metricz.
+	mux := http.NewServeMux()
+	mux.Handle("/debug/", h)
+	_ = mux
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"net/http"
+	"path"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Handler is an [http.Handler] that serves the live state of spans and
+// metrics. It is a [sdktrace.SpanProcessor] to record spans, and holds a
+// [sdkmetric.Reader] to collect metrics, see [Handler.Reader].
+type Handler struct {
+	cfg      config
+	spans    *spanStore
+	reader   *sdkmetric.ManualReader
+	shutdown atomic.Bool
+}
+
+var (
+	_ http.Handler           = (*Handler)(nil)
+	_ sdktrace.SpanProcessor = (*Handler)(nil)
+)
+
+// NewHandler returns a new Handler configured with opts.
+func NewHandler(opts ...Option) *Handler {
+	cfg := newConfig(opts)
+	return &Handler{
+		cfg:    cfg,
+		spans:  newSpanStore(cfg.sampleSize, cfg.maxSpanNames),
+		reader: sdkmetric.NewManualReader(),
+	}
+}
+
+// Reader returns the metric reader the metrics shown by h are collected with.
+// Register it with a [sdkmetric.MeterProvider] using [sdkmetric.WithReader].
+//
+// Metrics are only collected when the metricz page is requested.
+func (h *Handler) Reader() sdkmetric.Reader {
+	return h.reader
+}
+
+// OnStart records s as active.
+func (h *Handler) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	if h.shutdown.Load() {
+		return
+	}
+	h.spans.start(s)
+}
+
+// OnEnd records s as ended.
+func (h *Handler) OnEnd(s sdktrace.ReadOnlySpan) {
+	if h.shutdown.Load() {
+		return
+	}
+	h.spans.end(s)
+}
+
+// Shutdown stops recording spans. The spans recorded before are still
+// served.
+//
+// It does not shut down the metric reader of h. That is done by the
+// [sdkmetric.MeterProvider] it is registered with.
+func (h *Handler) Shutdown(context.Context) error {
+	h.shutdown.Store(true)
+	return nil
+}
+
+// ForceFlush does nothing. Spans are recorded when they end.
+func (*Handler) ForceFlush(context.Context) error {
+	return nil
+}
+
+// ServeHTTP serves the page of the last element of the request path: tracez,
+// metricz, or the index page for any other path.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var (
+		tmpl *template.Template
+		data any
+	)
+	switch path.Base(r.URL.Path) {
+	case "tracez":
+		tmpl, data = tracezTemplate, h.tracez(r)
+	case "metricz":
+		tmpl, data = metriczTemplate, h.metricz(r.Context())
+	default:
+		tmpl, data = indexTemplate, h.index()
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tmpl.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+type indexPage struct {
+	Sampler string
+	Queues  []queueRow
+}
+
+type queueRow struct {
+	Name   string
+	Length int
+}
+
+func (h *Handler) index() indexPage {
+	var p indexPage
+	if h.cfg.sampler != nil {
+		p.Sampler = h.cfg.sampler.Description()
+	}
+	for _, q := range h.cfg.queues {
+		p.Queues = append(p.Queues, queueRow{Name: q.name, Length: q.length()})
+	}
+	return p
+}
+
+type tracezPage struct {
+	Buckets   []string
+	Summaries []summary
+	Dropped   uint64
+
+	// Selection is the description of the selected sample spans. It is empty
+	// if none are selected.
+	Selection string
+	Spans     []spanRow
+}
+
+// Sample types of the tracez page.
+const (
+	sampleActive  = "active"
+	sampleLatency = "latency"
+	sampleError   = "error"
+)
+
+func (h *Handler) tracez(r *http.Request) tracezPage {
+	p := tracezPage{Buckets: make([]string, len(latencyBounds))}
+	for i, b := range latencyBounds {
+		p.Buckets[i] = ">" + b.String()
+	}
+	p.Summaries, p.Dropped = h.spans.summaries()
+
+	q := r.URL.Query()
+	name := q.Get("name")
+	if name == "" {
+		return p
+	}
+
+	var spans []sdktrace.ReadOnlySpan
+	switch q.Get("type") {
+	case sampleActive:
+		spans = h.spans.activeSamples(name)
+		p.Selection = "active spans"
+	case sampleError:
+		spans = h.spans.errorSamples(name)
+		p.Selection = "spans with errors"
+	case sampleLatency:
+		i, err := strconv.Atoi(q.Get("bucket"))
+		if err != nil || i < 0 || i >= len(latencyBounds) {
+			return p
+		}
+		spans = h.spans.latencySamples(name, i)
+		p.Selection = "spans with latency " + p.Buckets[i]
+	default:
+		return p
+	}
+	p.Selection = strconv.Quote(name) + " " + p.Selection
+
+	now := time.Now()
+	for _, s := range spans {
+		p.Spans = append(p.Spans, newSpanRow(s, now))
+	}
+	return p
+}
+
+type metriczPage struct {
+	Error   string
+	Metrics []metricRow
+}
+
+func (h *Handler) metricz(ctx context.Context) metriczPage {
+	var rm metricdata.ResourceMetrics
+	if err := h.reader.Collect(ctx, &rm); err != nil {
+		if errors.Is(err, sdkmetric.ErrReaderNotRegistered) {
+			return metriczPage{Error: "The reader is not registered with a MeterProvider."}
+		}
+		return metriczPage{Error: err.Error()}
+	}
+	return metriczPage{Metrics: newMetricRows(rm)}
+}
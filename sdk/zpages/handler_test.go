@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func get(t *testing.T, h http.Handler, target string) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestHandlerIndex(t *testing.T) {
+	h := NewHandler()
+	body := get(t, h, "/debug/")
+	assert.Contains(t, body, `<a href="tracez">`)
+	assert.Contains(t, body, `<a href="metricz">`)
+	assert.Contains(t, body, "<h2>Sampler</h2>\n<p>Not configured.</p>")
+
+	h = NewHandler(
+		WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+		WithQueue("batch", func() int { return 42 }),
+	)
+	body = get(t, h, "/debug/")
+	assert.Contains(t, body, "<p>ParentBased{root:AlwaysOnSampler")
+	assert.Contains(t, body, `<tr><td>batch</td><td class="count">42</td></tr>`)
+}
+
+func TestHandlerTracez(t *testing.T) {
+	h := NewHandler()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(h))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	_, active := tracer.Start(t.Context(), "<active>", trace.WithAttributes(attribute.String("key", "value")))
+	defer active.End()
+	// Fixed timestamps so the span is in the first latency bucket.
+	now := time.Now()
+	_, ended := tracer.Start(t.Context(), "ended", trace.WithTimestamp(now))
+	ended.End(trace.WithTimestamp(now))
+	_, failed := tracer.Start(t.Context(), "ended")
+	failed.AddEvent("exception")
+	failed.SetStatus(codes.Error, "boom")
+	failed.End()
+
+	body := get(t, h, "/debug/tracez")
+	assert.Contains(t, body, "<td>&lt;active&gt;</td>", "names are escaped")
+	assert.Contains(t, body, `<a href="tracez?name=%3cactive%3e&amp;type=active">1</a>`)
+	assert.Contains(t, body, `<a href="tracez?name=ended&amp;type=error">1</a>`)
+	assert.NotContains(t, body, "<h2>")
+
+	body = get(t, h, "/debug/tracez?name=%3Cactive%3E&type=active")
+	assert.Contains(t, body, "<h2>&#34;&lt;active&gt;&#34; active spans</h2>")
+	assert.Contains(t, body, active.SpanContext().SpanID().String())
+	assert.Contains(t, body, "running for ")
+	assert.Contains(t, body, "{key=value}")
+
+	body = get(t, h, "/debug/tracez?name=ended&type=error")
+	assert.Contains(t, body, failed.SpanContext().SpanID().String())
+	assert.Contains(t, body, "<td>Error: boom</td>")
+	assert.Contains(t, body, " exception<br>")
+	assert.NotContains(t, body, ended.SpanContext().SpanID().String())
+
+	body = get(t, h, "/debug/tracez?name=ended&type=latency&bucket=0")
+	assert.Contains(t, body, "<h2>&#34;ended&#34; spans with latency &gt;0s</h2>")
+	assert.Contains(t, body, ended.SpanContext().SpanID().String())
+
+	body = get(t, h, "/debug/tracez?name=ended&type=latency&bucket=8")
+	assert.Contains(t, body, "<p>No sample spans.</p>")
+
+	for _, q := range []string{"name=ended&type=latency&bucket=9", "name=ended&type=other", "type=active"} {
+		assert.NotContains(t, get(t, h, "/debug/tracez?"+q), "<h2>", q)
+	}
+}
+
+func TestHandlerShutdown(t *testing.T) {
+	h := NewHandler()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(h))
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "before")
+	span.End()
+	require.NoError(t, tp.Shutdown(t.Context()))
+	require.NoError(t, h.ForceFlush(t.Context()))
+
+	h.OnEnd(stub{name: "after"}.Snapshot())
+	sums, _ := h.spans.summaries()
+	require.Len(t, sums, 1)
+	assert.Equal(t, "before", sums[0].Name)
+}
+
+func TestHandlerMetricz(t *testing.T) {
+	h := NewHandler()
+	assert.Contains(t, get(t, h, "/metricz"), "The reader is not registered with a MeterProvider.")
+
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(h.Reader()))
+	t.Cleanup(func() { require.NoError(t, mp.Shutdown(context.Background())) })
+	assert.Contains(t, get(t, h, "/metricz"), "<p>No metrics.</p>")
+
+	meter := mp.Meter("scope", metric.WithInstrumentationVersion("v1"))
+	counter, err := meter.Int64Counter("requests", metric.WithUnit("{request}"))
+	require.NoError(t, err)
+	counter.Add(t.Context(), 3, metric.WithAttributes(attribute.String("method", "GET")))
+	hist, err := meter.Float64Histogram("latency")
+	require.NoError(t, err)
+	hist.Record(t.Context(), 2)
+	hist.Record(t.Context(), 4)
+
+	body := get(t, h, "/metricz")
+	assert.Contains(t, body, "<td>scope v1</td><td title=\"\">requests</td><td>{request}</td>"+
+		"<td>Sum (CumulativeTemporality, monotonic)</td>\n<td>{method=GET}</td><td>3</td>")
+	assert.Contains(t, body, "<td>count=2 sum=6 min=2 max=4</td>")
+}
+
+func TestHandlerMethodNotAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/tracez", http.NoBody))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET, HEAD", rec.Header().Get("Allow"))
+}
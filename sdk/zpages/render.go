@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type spanRow struct {
+	Name       string
+	Start      string
+	Duration   string
+	TraceID    string
+	SpanID     string
+	Parent     string
+	Kind       string
+	Status     string
+	Attributes string
+	Events     []string
+}
+
+// newSpanRow returns the rendering of s. The duration of active spans is the
+// time since they started until now.
+func newSpanRow(s sdktrace.ReadOnlySpan, now time.Time) spanRow {
+	duration := s.EndTime().Sub(s.StartTime()).String()
+	if s.EndTime().IsZero() {
+		duration = "running for " + now.Sub(s.StartTime()).String()
+	}
+
+	row := spanRow{
+		Name:       s.Name(),
+		Start:      s.StartTime().Format(time.RFC3339Nano),
+		Duration:   duration,
+		TraceID:    s.SpanContext().TraceID().String(),
+		SpanID:     s.SpanContext().SpanID().String(),
+		Kind:       s.SpanKind().String(),
+		Attributes: formatAttributes(s.Attributes()),
+	}
+	if p := s.Parent(); p.IsValid() {
+		row.Parent = p.SpanID().String()
+	}
+	if st := s.Status(); st.Code != codes.Unset {
+		row.Status = st.Code.String()
+		if st.Description != "" {
+			row.Status += ": " + st.Description
+		}
+	}
+	for _, e := range s.Events() {
+		event := fmt.Sprintf("+%s %s", e.Time.Sub(s.StartTime()), e.Name)
+		if len(e.Attributes) > 0 {
+			event += " " + formatAttributes(e.Attributes)
+		}
+		row.Events = append(row.Events, event)
+	}
+	return row
+}
+
+func formatAttributes(attrs []attribute.KeyValue) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	parts := make([]string, len(attrs))
+	for i, kv := range attrs {
+		parts[i] = string(kv.Key) + "=" + kv.Value.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+type metricRow struct {
+	Scope       string
+	Name        string
+	Description string
+	Unit        string
+	Type        string
+	Points      []pointRow
+}
+
+type pointRow struct {
+	Attributes string
+	Value      string
+}
+
+func newMetricRows(rm metricdata.ResourceMetrics) []metricRow {
+	var rows []metricRow
+	for _, sm := range rm.ScopeMetrics {
+		scope := sm.Scope.Name
+		if sm.Scope.Version != "" {
+			scope += " " + sm.Scope.Version
+		}
+		for _, m := range sm.Metrics {
+			row := metricRow{
+				Scope:       scope,
+				Name:        m.Name,
+				Description: m.Description,
+				Unit:        m.Unit,
+			}
+			row.Type, row.Points = renderData(m.Data)
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// renderData returns the type and the data points of data.
+func renderData(data metricdata.Aggregation) (string, []pointRow) {
+	switch d := data.(type) {
+	case metricdata.Sum[int64]:
+		return sumType(d.Temporality, d.IsMonotonic), renderPoints(d.DataPoints)
+	case metricdata.Sum[float64]:
+		return sumType(d.Temporality, d.IsMonotonic), renderPoints(d.DataPoints)
+	case metricdata.Gauge[int64]:
+		return "Gauge", renderPoints(d.DataPoints)
+	case metricdata.Gauge[float64]:
+		return "Gauge", renderPoints(d.DataPoints)
+	case metricdata.Histogram[int64]:
+		return "Histogram (" + d.Temporality.String() + ")", renderHistogram(d.DataPoints)
+	case metricdata.Histogram[float64]:
+		return "Histogram (" + d.Temporality.String() + ")", renderHistogram(d.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		return "ExponentialHistogram (" + d.Temporality.String() + ")", renderExponentialHistogram(d.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		return "ExponentialHistogram (" + d.Temporality.String() + ")", renderExponentialHistogram(d.DataPoints)
+	case metricdata.Summary:
+		points := make([]pointRow, len(d.DataPoints))
+		for i, dp := range d.DataPoints {
+			points[i] = pointRow{
+				Attributes: formatAttributes(dp.Attributes.ToSlice()),
+				Value:      fmt.Sprintf("count=%d sum=%v", dp.Count, dp.Sum),
+			}
+		}
+		return "Summary", points
+	default:
+		return fmt.Sprintf("%T", data), nil
+	}
+}
+
+func sumType(t metricdata.Temporality, monotonic bool) string {
+	if monotonic {
+		return "Sum (" + t.String() + ", monotonic)"
+	}
+	return "Sum (" + t.String() + ")"
+}
+
+func renderPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []pointRow {
+	points := make([]pointRow, len(dps))
+	for i, dp := range dps {
+		points[i] = pointRow{
+			Attributes: formatAttributes(dp.Attributes.ToSlice()),
+			Value:      fmt.Sprint(dp.Value),
+		}
+	}
+	return points
+}
+
+func renderHistogram[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []pointRow {
+	points := make([]pointRow, len(dps))
+	for i, dp := range dps {
+		var b strings.Builder
+		fmt.Fprintf(&b, "count=%d sum=%v", dp.Count, dp.Sum)
+		if v, ok := dp.Min.Value(); ok {
+			fmt.Fprintf(&b, " min=%v", v)
+		}
+		if v, ok := dp.Max.Value(); ok {
+			fmt.Fprintf(&b, " max=%v", v)
+		}
+		points[i] = pointRow{Attributes: formatAttributes(dp.Attributes.ToSlice()), Value: b.String()}
+	}
+	return points
+}
+
+func renderExponentialHistogram[N int64 | float64](dps []metricdata.ExponentialHistogramDataPoint[N]) []pointRow {
+	points := make([]pointRow, len(dps))
+	for i, dp := range dps {
+		var b strings.Builder
+		fmt.Fprintf(&b, "count=%d sum=%v scale=%d", dp.Count, dp.Sum, dp.Scale)
+		if v, ok := dp.Min.Value(); ok {
+			fmt.Fprintf(&b, " min=%v", v)
+		}
+		if v, ok := dp.Max.Value(); ok {
+			fmt.Fprintf(&b, " max=%v", v)
+		}
+		points[i] = pointRow{Attributes: formatAttributes(dp.Attributes.ToSlice()), Value: b.String()}
+	}
+	return points
+}
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// latencyBounds are the lower bounds of the latency buckets of ended spans.
+var latencyBounds = []time.Duration{
+	0,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+	100 * time.Second,
+}
+
+// latencyBucket returns the index of the latency bucket of d.
+func latencyBucket(d time.Duration) int {
+	return sort.Search(len(latencyBounds), func(i int) bool { return latencyBounds[i] > d }) - 1
+}
+
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func keyOf(s sdktrace.ReadOnlySpan) spanKey {
+	sc := s.SpanContext()
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+// samples holds the count of spans, and the most recent of them.
+type samples struct {
+	count uint64
+	// spans is a ring buffer of the most recent spans, next is the index the
+	// next span is written to.
+	spans []sdktrace.ReadOnlySpan
+	next  int
+}
+
+func (s *samples) add(span sdktrace.ReadOnlySpan, size int) {
+	s.count++
+	if len(s.spans) < size {
+		s.spans = append(s.spans, span)
+		return
+	}
+	s.spans[s.next] = span
+	s.next = (s.next + 1) % size
+}
+
+// recent returns the spans of s, most recent first.
+func (s *samples) recent() []sdktrace.ReadOnlySpan {
+	out := make([]sdktrace.ReadOnlySpan, 0, len(s.spans))
+	out = append(out, s.spans[s.next:]...)
+	out = append(out, s.spans[:s.next]...)
+	slices.Reverse(out)
+	return out
+}
+
+// spanName holds the summary of the spans with the same name.
+type spanName struct {
+	activeCount int
+	// active are the sample active spans. Ended spans are removed, and
+	// replaced by spans started afterwards.
+	active  map[spanKey]sdktrace.ReadOnlySpan
+	latency []samples
+	errors  samples
+}
+
+// spanStore is a bounded summary of active and ended spans.
+type spanStore struct {
+	sampleSize int
+	maxNames   int
+	// maxActive is the maximum number of tracked active spans.
+	maxActive int
+
+	mu    sync.Mutex
+	names map[string]*spanName
+	// startNames are the names of the tracked active spans when they
+	// started. Spans can be renamed before they end.
+	startNames map[spanKey]string
+	dropped    uint64
+}
+
+func newSpanStore(sampleSize, maxNames int) *spanStore {
+	return &spanStore{
+		sampleSize: sampleSize,
+		maxNames:   maxNames,
+		maxActive:  maxNames * sampleSize,
+		names:      make(map[string]*spanName),
+		startNames: make(map[spanKey]string),
+	}
+}
+
+// get returns the summary of spans named name, or nil if there are already
+// too many span names. It needs to be called with the lock of s held.
+func (s *spanStore) get(name string) *spanName {
+	n, ok := s.names[name]
+	if ok {
+		return n
+	}
+	if len(s.names) >= s.maxNames {
+		return nil
+	}
+	n = &spanName{
+		active:  make(map[spanKey]sdktrace.ReadOnlySpan),
+		latency: make([]samples, len(latencyBounds)),
+	}
+	s.names[name] = n
+	return n
+}
+
+func (s *spanStore) start(span sdktrace.ReadOnlySpan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.startNames) >= s.maxActive {
+		s.dropped++
+		return
+	}
+	n := s.get(span.Name())
+	if n == nil {
+		s.dropped++
+		return
+	}
+	key := keyOf(span)
+	s.startNames[key] = span.Name()
+	n.activeCount++
+	if len(n.active) < s.sampleSize {
+		n.active[key] = span
+	}
+}
+
+// end records the ended span.
+func (s *spanStore) end(span sdktrace.ReadOnlySpan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := keyOf(span)
+	startName, tracked := s.startNames[key]
+	if tracked {
+		delete(s.startNames, key)
+		n := s.names[startName]
+		n.activeCount--
+		delete(n.active, key)
+	}
+
+	n := s.get(span.Name())
+	if n == nil {
+		if tracked {
+			// Renamed to a name that is not tracked. Spans that were not
+			// tracked when they started are already counted.
+			s.dropped++
+		}
+		return
+	}
+	if span.Status().Code == codes.Error {
+		n.errors.add(span, s.sampleSize)
+		return
+	}
+	d := span.EndTime().Sub(span.StartTime())
+	n.latency[latencyBucket(d)].add(span, s.sampleSize)
+}
+
+// summary is the summary of the spans with the same name.
+type summary struct {
+	Name    string
+	Active  int
+	Latency []uint64
+	Errors  uint64
+}
+
+// summaries returns the summaries of all span names ordered by name, and the
+// number of spans that were not tracked.
+func (s *spanStore) summaries() ([]summary, uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]summary, 0, len(s.names))
+	for name, n := range s.names {
+		sum := summary{
+			Name:    name,
+			Active:  n.activeCount,
+			Latency: make([]uint64, len(n.latency)),
+			Errors:  n.errors.count,
+		}
+		for i, l := range n.latency {
+			sum.Latency[i] = l.count
+		}
+		out = append(out, sum)
+	}
+	slices.SortFunc(out, func(a, b summary) int { return strings.Compare(a.Name, b.Name) })
+	return out, s.dropped
+}
+
+// activeSamples returns the sample active spans named name, ordered by their
+// start time.
+func (s *spanStore) activeSamples(name string) []sdktrace.ReadOnlySpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.names[name]
+	if !ok {
+		return nil
+	}
+	out := make([]sdktrace.ReadOnlySpan, 0, len(n.active))
+	for _, span := range n.active {
+		out = append(out, span)
+	}
+	slices.SortFunc(out, func(a, b sdktrace.ReadOnlySpan) int {
+		return a.StartTime().Compare(b.StartTime())
+	})
+	return out
+}
+
+// latencySamples returns the most recent ended spans named name in the
+// latency bucket i, most recent first.
+func (s *spanStore) latencySamples(name string, i int) []sdktrace.ReadOnlySpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.names[name]
+	if !ok || i < 0 || i >= len(n.latency) {
+		return nil
+	}
+	return n.latency[i].recent()
+}
+
+// errorSamples returns the most recent ended spans named name with an error
+// status, most recent first.
+func (s *spanStore) errorSamples(name string) []sdktrace.ReadOnlySpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.names[name]
+	if !ok {
+		return nil
+	}
+	return n.errors.recent()
+}
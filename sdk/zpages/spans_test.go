@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLatencyBucket(t *testing.T) {
+	assert.Equal(t, 0, latencyBucket(0))
+	assert.Equal(t, 0, latencyBucket(9*time.Microsecond))
+	assert.Equal(t, 1, latencyBucket(10*time.Microsecond))
+	assert.Equal(t, 3, latencyBucket(5*time.Millisecond))
+	assert.Equal(t, 6, latencyBucket(time.Second))
+	assert.Equal(t, 8, latencyBucket(time.Hour))
+}
+
+func TestSamples(t *testing.T) {
+	var s samples
+	names := func() []string {
+		var out []string
+		for _, span := range s.recent() {
+			out = append(out, span.Name())
+		}
+		return out
+	}
+
+	assert.Empty(t, names())
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		s.add(stub{name: name}.Snapshot(), 3)
+	}
+	assert.Equal(t, uint64(5), s.count)
+	assert.Equal(t, []string{"e", "d", "c"}, names())
+}
+
+type stub struct {
+	name   string
+	id     byte
+	start  time.Time
+	end    time.Time
+	status codes.Code
+}
+
+func (s stub) Snapshot() sdktrace.ReadOnlySpan {
+	return tracetest.SpanStub{
+		Name: s.name,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{s.id},
+		}),
+		StartTime: s.start,
+		EndTime:   s.end,
+		Status:    sdktrace.Status{Code: s.status},
+	}.Snapshot()
+}
+
+func TestSpanStore(t *testing.T) {
+	store := newSpanStore(2, 2)
+	start := time.Now()
+
+	a1 := stub{name: "a", id: 1, start: start}
+	a2 := stub{name: "a", id: 2, start: start.Add(time.Millisecond)}
+	a3 := stub{name: "a", id: 3, start: start.Add(2 * time.Millisecond)}
+	store.start(a1.Snapshot())
+	store.start(a2.Snapshot())
+	store.start(a3.Snapshot())
+
+	sums, dropped := store.summaries()
+	require.Len(t, sums, 1)
+	assert.Equal(t, 3, sums[0].Active)
+	assert.Zero(t, dropped)
+	require.Len(t, store.activeSamples("a"), 2, "active samples are bounded")
+
+	a1.end = start.Add(5 * time.Millisecond)
+	store.end(a1.Snapshot())
+	a2.end = a2.start.Add(time.Second)
+	a2.status = codes.Error
+	store.end(a2.Snapshot())
+
+	sums, _ = store.summaries()
+	assert.Equal(t, 1, sums[0].Active)
+	assert.Equal(t, uint64(1), sums[0].Latency[3])
+	assert.Equal(t, uint64(1), sums[0].Errors)
+	assert.Empty(t, store.activeSamples("a"), "the sample of a3 was not kept")
+	require.Len(t, store.latencySamples("a", 3), 1)
+	assert.Equal(t, a1.Snapshot().SpanContext(), store.latencySamples("a", 3)[0].SpanContext())
+	require.Len(t, store.errorSamples("a"), 1)
+	assert.Nil(t, store.latencySamples("a", -1))
+	assert.Nil(t, store.latencySamples("missing", 0))
+	assert.Nil(t, store.errorSamples("missing"))
+	assert.Nil(t, store.activeSamples("missing"))
+
+	// Span names are bounded.
+	store.start(stub{name: "b", id: 4, start: start}.Snapshot())
+	store.start(stub{name: "c", id: 5, start: start}.Snapshot())
+	store.end(stub{name: "c", id: 5, start: start, end: start}.Snapshot())
+	sums, dropped = store.summaries()
+	require.Len(t, sums, 2)
+	assert.Equal(t, "a", sums[0].Name)
+	assert.Equal(t, "b", sums[1].Name)
+	assert.Equal(t, uint64(1), dropped)
+}
+
+func TestSpanStoreMaxActive(t *testing.T) {
+	store := newSpanStore(1, 2)
+	start := time.Now()
+
+	for i := range 3 {
+		store.start(stub{name: "a", id: byte(i + 1), start: start}.Snapshot())
+	}
+	sums, dropped := store.summaries()
+	require.Len(t, sums, 1)
+	assert.Equal(t, 2, sums[0].Active, "active spans are bounded")
+	assert.Equal(t, uint64(1), dropped)
+	assert.Len(t, store.startNames, 2)
+
+	store.end(stub{name: "a", id: 1, start: start, end: start}.Snapshot())
+	store.start(stub{name: "a", id: 4, start: start}.Snapshot())
+	sums, dropped = store.summaries()
+	assert.Equal(t, 2, sums[0].Active, "tracked after a span ended")
+	assert.Equal(t, uint64(1), dropped)
+}
+
+func TestSpanStoreRenamed(t *testing.T) {
+	store := newSpanStore(2, 10)
+	start := time.Now()
+
+	store.start(stub{name: "before", id: 1, start: start}.Snapshot())
+	store.end(stub{name: "after", id: 1, start: start, end: start}.Snapshot())
+
+	sums, _ := store.summaries()
+	require.Len(t, sums, 2)
+	assert.Equal(t, "after", sums[0].Name)
+	assert.Equal(t, uint64(1), sums[0].Latency[0])
+	assert.Equal(t, "before", sums[1].Name)
+	assert.Zero(t, sums[1].Active)
+}
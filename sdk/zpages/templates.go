@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import "html/template"
+
+const style = `<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; text-align: left; vertical-align: top; }
+td.count { text-align: right; }
+</style>`
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html><head><title>zPages</title>` + style + `</head>
+<body>
+<h1>zPages</h1>
+<ul>
+<li><a href="tracez">tracez</a>: active and recent spans</li>
+<li><a href="metricz">metricz</a>: current metric values</li>
+</ul>
+<h2>Sampler</h2>
+{{if .Sampler}}<p>{{.Sampler}}</p>{{else}}<p>Not configured.</p>{{end}}
+<h2>Queues</h2>
+{{if .Queues}}<table>
+<tr><th>Queue</th><th>Length</th></tr>
+{{range .Queues}}<tr><td>{{.Name}}</td><td class="count">{{.Length}}</td></tr>
+{{end}}</table>{{else}}<p>Not configured.</p>{{end}}
+</body></html>
+`))
+
+var tracezTemplate = template.Must(template.New("tracez").Parse(`<!DOCTYPE html>
+<html><head><title>tracez</title>` + style + `</head>
+<body>
+<h1>tracez</h1>
+<table>
+<tr><th>Span name</th><th>Active</th>{{range .Buckets}}<th>{{.}}</th>{{end}}<th>Errors</th></tr>
+{{range .Summaries}}{{$name := .Name}}<tr>
+<td>{{.Name}}</td>
+<td class="count"><a href="tracez?name={{.Name}}&amp;type=active">{{.Active}}</a></td>
+{{range $i, $n := .Latency}}<td class="count">
+<a href="tracez?name={{$name}}&amp;type=latency&amp;bucket={{$i}}">{{$n}}</a></td>
+{{end}}<td class="count"><a href="tracez?name={{.Name}}&amp;type=error">{{.Errors}}</a></td>
+</tr>
+{{end}}</table>
+{{if .Dropped}}<p>{{.Dropped}} spans were not tracked because there are too many span names or active spans.</p>{{end}}
+{{if .Selection}}<h2>{{.Selection}}</h2>
+{{if .Spans}}<table>
+<tr><th>Start</th><th>Duration</th><th>Trace ID</th><th>Span ID</th><th>Parent ID</th>
+<th>Kind</th><th>Status</th><th>Attributes</th><th>Events</th></tr>
+{{range .Spans}}<tr>
+<td>{{.Start}}</td><td>{{.Duration}}</td><td>{{.TraceID}}</td><td>{{.SpanID}}</td><td>{{.Parent}}</td>
+<td>{{.Kind}}</td><td>{{.Status}}</td><td>{{.Attributes}}</td>
+<td>{{range .Events}}{{.}}<br>{{end}}</td>
+</tr>
+{{end}}</table>{{else}}<p>No sample spans.</p>{{end}}{{end}}
+</body></html>
+`))
+
+var metriczTemplate = template.Must(template.New("metricz").Parse(`<!DOCTYPE html>
+<html><head><title>metricz</title>` + style + `</head>
+<body>
+<h1>metricz</h1>
+{{if .Error}}<p>{{.Error}}</p>{{else if .Metrics}}<table>
+<tr><th>Scope</th><th>Metric</th><th>Unit</th><th>Type</th><th>Attributes</th><th>Value</th></tr>
+{{range .Metrics}}{{$m := .}}{{range .Points}}<tr>
+<td>{{$m.Scope}}</td><td title="{{$m.Description}}">{{$m.Name}}</td><td>{{$m.Unit}}</td><td>{{$m.Type}}</td>
+<td>{{.Attributes}}</td><td>{{.Value}}</td>
+</tr>
+{{end}}{{end}}</table>{{else}}<p>No metrics.</p>{{end}}
+</body></html>
+`))